@@ -8,7 +8,8 @@ import (
 
 func main() {
 	// register sigterm/sigint signals
-	shutdownCtx := monitor.SetupSignalContext(context.TODO())
+	shutdownCtx, cleanupSignalContext := monitor.SetupSignalContext(context.TODO())
+	defer cleanupSignalContext()
 
 	// start monitor
 	sm := monitor.New(nil).
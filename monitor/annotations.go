@@ -0,0 +1,52 @@
+package monitor
+
+import "fmt"
+
+// defaultAnnotationPrefix is the annotation domain applied to a fallback pod's manifest when no
+// override is set via WithAnnotationPrefix (or --annotation-prefix for the "fallback"
+// subcommand), so an operator that needs to avoid clashing with another controller's
+// annotations under the same domain can rename it.
+const defaultAnnotationPrefix = "startup-monitor.static-pods.openshift.io"
+
+// Suffixes of the annotations applied to a fallback pod's manifest, under whichever domain
+// prefix is in effect (see annotationKey), so an operator can reconstruct what happened from
+// the pod manifest alone, without needing to correlate it with the fallback record, event, or
+// operator condition.
+const (
+	// fallbackForRevisionAnnotationSuffix records the revision the fallback pod is standing in
+	// for.
+	fallbackForRevisionAnnotationSuffix = "fallback-for-revision"
+
+	// fallbackTimestampAnnotationSuffix records when the fallback was performed, RFC3339 in
+	// UTC.
+	fallbackTimestampAnnotationSuffix = "fallback-timestamp"
+
+	// fallbackReasonAnnotationSuffix records why the fallback was performed, e.g. a timeout
+	// reason optionally combined with a container exit diagnostics summary. Omitted when no
+	// reason is available.
+	fallbackReasonAnnotationSuffix = "fallback-reason"
+
+	// fallbackProbeCountAnnotationSuffix records how many times the health checker had been
+	// called for the abandoned revision before the fallback was performed.
+	fallbackProbeCountAnnotationSuffix = "fallback-probe-count"
+
+	// monitorVersionAnnotationSuffix records the version of the startup-monitor binary that
+	// performed the fallback. See Version.
+	monitorVersionAnnotationSuffix = "monitor-version"
+)
+
+// annotationKey returns the fully-qualified annotation key for suffix under prefix, defaulting
+// to defaultAnnotationPrefix when prefix is empty.
+func annotationKey(prefix, suffix string) string {
+	if prefix == "" {
+		prefix = defaultAnnotationPrefix
+	}
+	return fmt.Sprintf("%s/%s", prefix, suffix)
+}
+
+// annotationKey returns the fully-qualified annotation key for suffix under sm's configured
+// annotation domain prefix, defaulting to defaultAnnotationPrefix when unset. See
+// WithAnnotationPrefix.
+func (sm *StartupMonitor) annotationKey(suffix string) string {
+	return annotationKey(sm.annotationPrefix, suffix)
+}
@@ -0,0 +1,342 @@
+package monitor
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+// These tests exercise recordFallback, patchOperatorCondition and recordEvent, the monitor's
+// API integrations, against a fake API server rather than a real one. A true envtest (a real
+// etcd/kube-apiserver binary driven through sigs.k8s.io/controller-runtime's envtest package)
+// isn't available in this tree: controller-runtime isn't vendored or cached offline here, and
+// there's no apiserver binary to run even if it were. client-go's fake clientsets, already
+// available offline as part of the vendored client-go dependency, give the same guarantee that
+// matters for these integrations: the exact objects and patches recordFallback and
+// patchOperatorCondition send reach a tracked object store unchanged.
+
+func TestRecordFallbackCreatesConfigMap(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	sm := New(nil).WithClient(client)
+	sm.targetName = "kube-apiserver"
+	sm.targetNamespace = "openshift-kube-apiserver"
+	t.Setenv(NodeNameEnvVar, "node-1")
+
+	if err := sm.recordFallback(context.Background(), 8, 9, "probe failed"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	configMap, err := client.CoreV1().ConfigMaps(sm.targetNamespace).Get(context.Background(), fallbackRecordConfigMapName(sm.targetName, "node-1"), metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("expected the fallback record ConfigMap to exist: %v", err)
+	}
+	if configMap.Data["fromRevision"] != "8" || configMap.Data["toRevision"] != "9" {
+		t.Errorf("unexpected revisions recorded: %+v", configMap.Data)
+	}
+	if configMap.Data["diagnostics"] != "probe failed" {
+		t.Errorf("expected diagnostics to be recorded, got %+v", configMap.Data)
+	}
+	if configMap.Labels["startup-monitor.static-pods.openshift.io/node"] != "node-1" {
+		t.Errorf("expected the ConfigMap to be labeled with the node name, got %+v", configMap.Labels)
+	}
+}
+
+func TestRecordFallbackUpdatesExistingConfigMap(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	sm := New(nil).WithClient(client)
+	sm.targetName = "kube-apiserver"
+	sm.targetNamespace = "openshift-kube-apiserver"
+	t.Setenv(NodeNameEnvVar, "node-1")
+
+	if err := sm.recordFallback(context.Background(), 7, 8, ""); err != nil {
+		t.Fatalf("unexpected error on the first fallback: %v", err)
+	}
+	if err := sm.recordFallback(context.Background(), 8, 9, ""); err != nil {
+		t.Fatalf("unexpected error on the second fallback: %v", err)
+	}
+
+	configMap, err := client.CoreV1().ConfigMaps(sm.targetNamespace).Get(context.Background(), fallbackRecordConfigMapName(sm.targetName, "node-1"), metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("expected the fallback record ConfigMap to exist: %v", err)
+	}
+	if configMap.Data["fromRevision"] != "8" || configMap.Data["toRevision"] != "9" {
+		t.Errorf("expected the record to reflect the latest fallback, got %+v", configMap.Data)
+	}
+}
+
+// TestRecordFallbackUpdatesExistingConfigMapWithoutResourceVersion guards against recordFallback
+// regressing to an Update call on an object it never Get-fetched: a real apiserver rejects any
+// Update whose metadata.resourceVersion is empty, but client-go's fake ObjectTracker doesn't
+// enforce that, so TestRecordFallbackUpdatesExistingConfigMap alone would pass even if
+// recordFallback sent an Update with no ResourceVersion set. This test installs a reactor that
+// mirrors the real server's rejection, so a regression back to Update fails loudly here.
+func TestRecordFallbackUpdatesExistingConfigMapWithoutResourceVersion(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	client.PrependReactor("update", "configmaps", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		configMap := action.(clienttesting.UpdateAction).GetObject().(*corev1.ConfigMap)
+		if configMap.ResourceVersion == "" {
+			return true, nil, apierrors.NewInvalid(corev1.SchemeGroupVersion.WithKind("ConfigMap").GroupKind(), configMap.Name, field.ErrorList{
+				field.Required(field.NewPath("metadata", "resourceVersion"), "must be specified for an update"),
+			})
+		}
+		return false, nil, nil
+	})
+
+	sm := New(nil).WithClient(client)
+	sm.targetName = "kube-apiserver"
+	sm.targetNamespace = "openshift-kube-apiserver"
+	t.Setenv(NodeNameEnvVar, "node-1")
+
+	if err := sm.recordFallback(context.Background(), 7, 8, ""); err != nil {
+		t.Fatalf("unexpected error on the first fallback: %v", err)
+	}
+	if err := sm.recordFallback(context.Background(), 8, 9, ""); err != nil {
+		t.Fatalf("unexpected error on the second fallback: %v", err)
+	}
+
+	configMap, err := client.CoreV1().ConfigMaps(sm.targetNamespace).Get(context.Background(), fallbackRecordConfigMapName(sm.targetName, "node-1"), metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("expected the fallback record ConfigMap to exist: %v", err)
+	}
+	if configMap.Data["fromRevision"] != "8" || configMap.Data["toRevision"] != "9" {
+		t.Errorf("expected the record to reflect the latest fallback, got %+v", configMap.Data)
+	}
+}
+
+func TestRecordFallbackWithoutClientIsANoOp(t *testing.T) {
+	sm := New(nil)
+	sm.targetName = "kube-apiserver"
+
+	if err := sm.recordFallback(context.Background(), 8, 9, ""); err != nil {
+		t.Fatalf("expected no error when no client is configured, got %v", err)
+	}
+}
+
+var testOperatorResource = schema.GroupVersionResource{Group: "operator.openshift.io", Version: "v1", Resource: "kubeapiservers"}
+
+func newTestOperatorObject() *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "operator.openshift.io/v1",
+			"kind":       "KubeAPIServer",
+			"metadata": map[string]interface{}{
+				"name": clusterOperatorResourceName,
+			},
+		},
+	}
+}
+
+func newTestDynamicClient(objects ...runtime.Object) *dynamicfake.FakeDynamicClient {
+	scheme := runtime.NewScheme()
+	listKinds := map[schema.GroupVersionResource]string{testOperatorResource: "KubeAPIServerList"}
+	return dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme, listKinds, objects...)
+}
+
+func TestPatchOperatorConditionSetsNewCondition(t *testing.T) {
+	client := newTestDynamicClient(newTestOperatorObject())
+	sm := New(nil).WithDynamicClient(client).WithOperatorResource(testOperatorResource)
+
+	if err := sm.patchOperatorCondition(context.Background(), FallbackConditionType, "ProbeFailed", "node fell back"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	operator, err := client.Resource(testOperatorResource).Get(context.Background(), clusterOperatorResourceName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get the operator resource: %v", err)
+	}
+	conditions, _, err := unstructured.NestedSlice(operator.Object, "status", "conditions")
+	if err != nil {
+		t.Fatalf("failed to read conditions: %v", err)
+	}
+	if len(conditions) != 1 {
+		t.Fatalf("expected exactly one condition, got %d", len(conditions))
+	}
+	condition := conditions[0].(map[string]interface{})
+	if condition["type"] != FallbackConditionType || condition["status"] != "True" || condition["reason"] != "ProbeFailed" || condition["message"] != "node fell back" {
+		t.Errorf("unexpected condition: %+v", condition)
+	}
+}
+
+func TestPatchOperatorConditionReplacesExistingConditionOfSameType(t *testing.T) {
+	operator := newTestOperatorObject()
+	existingCondition := map[string]interface{}{
+		"type":    FallbackConditionType,
+		"status":  "True",
+		"reason":  "Stale",
+		"message": "stale message",
+	}
+	if err := unstructured.SetNestedSlice(operator.Object, []interface{}{existingCondition}, "status", "conditions"); err != nil {
+		t.Fatalf("failed to seed the existing condition: %v", err)
+	}
+	client := newTestDynamicClient(operator)
+	sm := New(nil).WithDynamicClient(client).WithOperatorResource(testOperatorResource)
+
+	if err := sm.patchOperatorCondition(context.Background(), FallbackConditionType, "ProbeFailed", "fresh message"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	updated, err := client.Resource(testOperatorResource).Get(context.Background(), clusterOperatorResourceName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get the operator resource: %v", err)
+	}
+	conditions, _, err := unstructured.NestedSlice(updated.Object, "status", "conditions")
+	if err != nil {
+		t.Fatalf("failed to read conditions: %v", err)
+	}
+	if len(conditions) != 1 {
+		t.Fatalf("expected the existing condition to be replaced in place, got %d conditions", len(conditions))
+	}
+	condition := conditions[0].(map[string]interface{})
+	if condition["reason"] != "ProbeFailed" || condition["message"] != "fresh message" {
+		t.Errorf("expected the condition to be replaced with the fresh one, got %+v", condition)
+	}
+}
+
+func TestPatchOperatorFallbackConditionMessageIncludesRevisions(t *testing.T) {
+	client := newTestDynamicClient(newTestOperatorObject())
+	sm := New(nil).WithDynamicClient(client).WithOperatorResource(testOperatorResource)
+	t.Setenv(NodeNameEnvVar, "node-1")
+
+	if err := sm.patchOperatorFallbackCondition(context.Background(), 8, 9, ReasonTimeout, "probe failed"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	operator, err := client.Resource(testOperatorResource).Get(context.Background(), clusterOperatorResourceName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get the operator resource: %v", err)
+	}
+	conditions, _, err := unstructured.NestedSlice(operator.Object, "status", "conditions")
+	if err != nil {
+		t.Fatalf("failed to read conditions: %v", err)
+	}
+	condition := conditions[0].(map[string]interface{})
+	message, _ := condition["message"].(string)
+	if message == "" {
+		t.Fatalf("expected a non-empty message")
+	}
+}
+
+func TestPatchOperatorConditionWithoutOperatorResourceIsANoOp(t *testing.T) {
+	sm := New(nil)
+
+	if err := sm.patchOperatorCondition(context.Background(), FallbackConditionType, "ProbeFailed", "node fell back"); err != nil {
+		t.Fatalf("expected no error when no operator resource is configured, got %v", err)
+	}
+}
+
+func TestRecordFallbackEventTargetsMirrorPodWhenPresent(t *testing.T) {
+	mirrorPod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver-node-1", Namespace: "openshift-kube-apiserver", UID: "mirror-pod-uid"}}
+	client := fake.NewSimpleClientset(mirrorPod)
+	sm := New(nil).WithClient(client)
+	sm.targetName = "kube-apiserver"
+	sm.targetNamespace = "openshift-kube-apiserver"
+	t.Setenv(NodeNameEnvVar, "node-1")
+
+	if err := sm.recordFallbackEvent(context.Background(), 8, 9, ReasonTimeout, "probe failed"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	events, err := client.CoreV1().Events(sm.targetNamespace).List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("failed to list events: %v", err)
+	}
+	if len(events.Items) != 1 {
+		t.Fatalf("expected exactly one event, got %d", len(events.Items))
+	}
+	event := events.Items[0]
+	if event.Type != corev1.EventTypeWarning {
+		t.Errorf("expected a Warning event, got %q", event.Type)
+	}
+	if event.Reason != string(ReasonTimeout) {
+		t.Errorf("unexpected reason %q", event.Reason)
+	}
+	if event.InvolvedObject.Kind != "Pod" || event.InvolvedObject.Name != mirrorPod.Name || event.InvolvedObject.UID != mirrorPod.UID {
+		t.Errorf("expected the event to target the mirror pod, got %+v", event.InvolvedObject)
+	}
+	if !strings.Contains(event.Message, "revision 8") || !strings.Contains(event.Message, "revision 9") {
+		t.Errorf("expected the message to include both revisions, got %q", event.Message)
+	}
+}
+
+func TestRecordFallbackEventFallsBackToNodeWhenNoMirrorPod(t *testing.T) {
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1", UID: "node-uid"}}
+	client := fake.NewSimpleClientset(node)
+	sm := New(nil).WithClient(client)
+	sm.targetName = "kube-apiserver"
+	sm.targetNamespace = "openshift-kube-apiserver"
+	t.Setenv(NodeNameEnvVar, "node-1")
+
+	if err := sm.recordFallbackEvent(context.Background(), 8, 9, ReasonTimeout, ""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	events, err := client.CoreV1().Events(metav1.NamespaceDefault).List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("failed to list events: %v", err)
+	}
+	if len(events.Items) != 1 {
+		t.Fatalf("expected exactly one event, got %d", len(events.Items))
+	}
+	if involved := events.Items[0].InvolvedObject; involved.Kind != "Node" || involved.Name != node.Name || involved.UID != node.UID {
+		t.Errorf("expected the event to target the node, got %+v", involved)
+	}
+}
+
+func TestRecordHealthyEventCreatesNormalEvent(t *testing.T) {
+	mirrorPod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver-node-1", Namespace: "openshift-kube-apiserver"}}
+	client := fake.NewSimpleClientset(mirrorPod)
+	sm := New(nil).WithClient(client)
+	sm.targetName = "kube-apiserver"
+	sm.targetNamespace = "openshift-kube-apiserver"
+	t.Setenv(NodeNameEnvVar, "node-1")
+
+	if err := sm.recordHealthyEvent(context.Background(), 9); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	events, err := client.CoreV1().Events(sm.targetNamespace).List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("failed to list events: %v", err)
+	}
+	if len(events.Items) != 1 {
+		t.Fatalf("expected exactly one event, got %d", len(events.Items))
+	}
+	if events.Items[0].Type != corev1.EventTypeNormal {
+		t.Errorf("expected a Normal event, got %q", events.Items[0].Type)
+	}
+}
+
+func TestRecordEventWithoutClientIsANoOp(t *testing.T) {
+	sm := New(nil)
+	sm.targetName = "kube-apiserver"
+
+	if err := sm.recordFallbackEvent(context.Background(), 8, 9, ReasonTimeout, ""); err != nil {
+		t.Fatalf("expected no error when no client is configured, got %v", err)
+	}
+	if err := sm.recordHealthyEvent(context.Background(), 9); err != nil {
+		t.Fatalf("expected no error when no client is configured, got %v", err)
+	}
+}
+
+func TestRecordEventWithNeitherMirrorPodNorNodeReturnsError(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	sm := New(nil).WithClient(client)
+	sm.targetName = "kube-apiserver"
+	sm.targetNamespace = "openshift-kube-apiserver"
+	t.Setenv(NodeNameEnvVar, "node-1")
+
+	if err := sm.recordHealthyEvent(context.Background(), 9); err == nil {
+		t.Fatal("expected an error when neither the mirror pod nor the node can be found")
+	}
+}
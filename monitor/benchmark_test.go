@@ -0,0 +1,130 @@
+package monitor
+
+import (
+	"context"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/p0lyn0mial/startup-monitor/monitor/monitortesting"
+)
+
+// newBenchmarkTarget builds a StartupMonitor against realFS and a fresh directory under b.TempDir,
+// seeded with a realistic, full-sized static pod manifest (the same fixture the golden and
+// fuzz tests use) for both the root and revisioned manifests, so the benchmarked path pays the
+// same YAML/JSON marshal and unmarshal cost a real cluster's manifests would.
+func newBenchmarkTarget(b *testing.B, revision int) *StartupMonitor {
+	b.Helper()
+
+	fixture, err := os.ReadFile("testdata/scenario-1-pod.yaml")
+	if err != nil {
+		b.Fatalf("failed to read the fixture: %v", err)
+	}
+
+	root := b.TempDir()
+	manifestsPath := path.Join(root, "manifests")
+	staticPodResourcesPath := path.Join(root, "static-pod-resources")
+	if err := os.MkdirAll(manifestsPath, 0755); err != nil {
+		b.Fatalf("failed to create manifestsPath: %v", err)
+	}
+	if err := os.MkdirAll(staticPodResourcesPath, 0755); err != nil {
+		b.Fatalf("failed to create staticPodResourcesPath: %v", err)
+	}
+
+	target := New(HealthCheckerFunc(func(ctx context.Context) (bool, string, error) { return true, "", nil }))
+	target.io = realFS{}
+	target.revision = revision
+	target.targetName = "kube-apiserver"
+	target.manifestsPath = manifestsPath
+	target.staticPodResourcesPath = staticPodResourcesPath
+
+	rootManifestPath := target.rootManifestPath()
+	if err := os.WriteFile(rootManifestPath, fixture, 0644); err != nil {
+		b.Fatalf("failed to write the root manifest: %v", err)
+	}
+
+	revisionedManifestPath := target.targetManifestPathFor(revision)
+	if err := os.MkdirAll(path.Dir(revisionedManifestPath), 0755); err != nil {
+		b.Fatalf("failed to create the revision directory: %v", err)
+	}
+	if err := os.WriteFile(revisionedManifestPath, fixture, 0644); err != nil {
+		b.Fatalf("failed to write the revisioned manifest: %v", err)
+	}
+
+	return target
+}
+
+// BenchmarkSync measures a full, healthy sync() iteration: reading and parsing the root
+// manifest, checking the (cached) health probe result, and creating the last known good
+// revision. This is the hot path executed once per probeInterval (as low as 1s in production),
+// so a regression here is directly felt as extra CPU/latency on every node running the monitor.
+func BenchmarkSync(b *testing.B) {
+	target := newBenchmarkTarget(b, 8)
+	target.probeCache.set(true)
+	ctx := context.Background()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := target.sync(ctx); err != nil {
+			b.Fatalf("unexpected error: %v", err)
+		}
+	}
+}
+
+// BenchmarkFallbackToPreviousRevision measures fallbackToPreviousRevision against realFS and a
+// realistic, full-sized manifest, covering the read, annotate, remove, and write sequence a
+// real fallback performs, so a regression in the hot path taken once the operand is judged
+// unhealthy is visible.
+func BenchmarkFallbackToPreviousRevision(b *testing.B) {
+	target := newBenchmarkTarget(b, 9)
+	writeIntegrationRevision8 := func() {
+		fixture, err := os.ReadFile("testdata/scenario-1-pod.yaml")
+		if err != nil {
+			b.Fatalf("failed to read the fixture: %v", err)
+		}
+		prevRevisionPath := target.targetManifestPathFor(8)
+		if err := os.MkdirAll(path.Dir(prevRevisionPath), 0755); err != nil {
+			b.Fatalf("failed to create the revision 8 directory: %v", err)
+		}
+		if err := os.WriteFile(prevRevisionPath, fixture, 0644); err != nil {
+			b.Fatalf("failed to write the revision 8 manifest: %v", err)
+		}
+	}
+	writeIntegrationRevision8()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := target.fallbackToPreviousRevision(""); err != nil {
+			b.Fatalf("unexpected error: %v", err)
+		}
+	}
+}
+
+// BenchmarkFallbackToPreviousRevisionUnderFaults measures fallbackToPreviousRevision with a
+// FaultInjectingIO configured for a modest transient error rate, so the benchmark also captures
+// the cost of retryOnTransientError's backoff on the hot path, not just the happy path.
+func BenchmarkFallbackToPreviousRevisionUnderFaults(b *testing.B) {
+	target := newBenchmarkTarget(b, 9)
+	fixture, err := os.ReadFile("testdata/scenario-1-pod.yaml")
+	if err != nil {
+		b.Fatalf("failed to read the fixture: %v", err)
+	}
+	prevRevisionPath := target.targetManifestPathFor(8)
+	if err := os.MkdirAll(path.Dir(prevRevisionPath), 0755); err != nil {
+		b.Fatalf("failed to create the revision 8 directory: %v", err)
+	}
+	if err := os.WriteFile(prevRevisionPath, fixture, 0644); err != nil {
+		b.Fatalf("failed to write the revision 8 manifest: %v", err)
+	}
+
+	target.io = monitortesting.NewFaultInjectingIO(target.io, monitortesting.FaultConfig{ErrorRate: 0.1})
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for {
+			if err := target.fallbackToPreviousRevision(""); err == nil {
+				break
+			}
+		}
+	}
+}
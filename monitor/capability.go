@@ -0,0 +1,67 @@
+package monitor
+
+import "k8s.io/klog/v2"
+
+// hasClient reports whether a Kubernetes client is configured, unlocking client-backed
+// features (mirror pod readiness, kubelet liveness, revision cross-checks, machine config
+// state, fallback records). The monitor's whole purpose is to act when the apiserver may be
+// down, so every one of these features must degrade gracefully, not fail, when absent.
+func (sm *StartupMonitor) hasClient() bool {
+	return sm.client != nil
+}
+
+// hasDynamicClient reports whether a dynamic client is configured, unlocking read-only
+// dynamic-client-backed features (control plane topology detection).
+func (sm *StartupMonitor) hasDynamicClient() bool {
+	return sm.dynamicClient != nil
+}
+
+// hasOperatorResource reports whether a dynamic client and an operator.openshift.io resource
+// are both configured, unlocking features that patch the operator's status (the fallback and
+// fallback-blocked conditions) or read its Progressing condition.
+func (sm *StartupMonitor) hasOperatorResource() bool {
+	return sm.dynamicClient != nil && !sm.operatorResource.Empty()
+}
+
+// logCapabilities logs, once at startup, which API-backed features are active and which are
+// disabled, so it's obvious from the logs alone whether the monitor is running fully online,
+// fully offline, or somewhere in between.
+func (sm *StartupMonitor) logCapabilities() {
+	if sm.hasClient() {
+		klog.Infof("Kubernetes client configured for %s: mirror pod readiness, kubelet liveness, revision cross-checks, machine config awareness, peer apiserver checks, and fallback records are enabled", sm.targetName)
+	} else {
+		klog.Warningf("No Kubernetes client configured for %s: running fully offline, all API-backed features are disabled", sm.targetName)
+	}
+
+	if sm.hasDynamicClient() {
+		klog.Infof("Dynamic client configured for %s: control plane topology detection is enabled", sm.targetName)
+	} else {
+		klog.Infof("No dynamic client configured for %s: control plane topology detection is disabled", sm.targetName)
+	}
+
+	if sm.hasOperatorResource() {
+		klog.Infof("Operator resource %s configured for %s: rollout-progressing awareness and operator fallback conditions are enabled", sm.operatorResource.Resource, sm.targetName)
+	} else {
+		klog.Infof("No operator resource configured for %s: rollout-progressing awareness and operator fallback conditions are disabled", sm.targetName)
+	}
+
+	if sm.reportNodeState {
+		if sm.hasClient() {
+			klog.Infof("Node state reporting enabled for %s: the %q annotation will be kept up to date", sm.targetName, sm.nodeStateAnnotationKey())
+		} else {
+			klog.Warningf("Node state reporting enabled for %s but no Kubernetes client is configured, it will have no effect", sm.targetName)
+		}
+	}
+
+	if len(sm.servingCertFile) > 0 && len(sm.servingKeyFile) > 0 {
+		klog.Infof("Serving certificate validation configured for %s at %q/%q", sm.targetName, sm.servingCertFile, sm.servingKeyFile)
+	} else {
+		klog.Infof("No serving certificate configured for %s: serving certificate validation is disabled", sm.targetName)
+	}
+
+	if len(sm.criSocket) > 0 {
+		klog.Infof("CRI socket %q configured for %s: container exit diagnostics are enabled", sm.criSocket, sm.targetName)
+	} else {
+		klog.Infof("No CRI socket configured for %s: container exit diagnostics are disabled", sm.targetName)
+	}
+}
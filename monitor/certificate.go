@@ -0,0 +1,50 @@
+package monitor
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"time"
+)
+
+// isServingCertificateValid reports whether the configured serving certificate/key pair is
+// parseable, matches, and is currently within its validity window. Cert problems are a common
+// cause of "apiserver up but unusable" that a pure TCP or health-endpoint probe misses.
+//
+// This is an additional, optional health signal: it requires servingCertFile and
+// servingKeyFile to both be set, and when either is empty it reports valid so monitors that
+// don't configure it behave exactly as before.
+func (sm *StartupMonitor) isServingCertificateValid() (bool, error) {
+	if len(sm.servingCertFile) == 0 || len(sm.servingKeyFile) == 0 {
+		return true, nil
+	}
+
+	certPEM, err := sm.io.ReadFile(sm.servingCertFile)
+	if err != nil {
+		return false, err
+	}
+	keyPEM, err := sm.io.ReadFile(sm.servingKeyFile)
+	if err != nil {
+		return false, err
+	}
+
+	keyPair, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return false, fmt.Errorf("serving certificate/key pair at %q/%q doesn't parse or match: %v", sm.servingCertFile, sm.servingKeyFile, err)
+	}
+
+	cert, err := x509.ParseCertificate(keyPair.Certificate[0])
+	if err != nil {
+		return false, fmt.Errorf("failed to parse serving certificate at %q: %v", sm.servingCertFile, err)
+	}
+
+	now := time.Now()
+	if now.Before(cert.NotBefore) {
+		return false, fmt.Errorf("serving certificate at %q is not yet valid, NotBefore=%s", sm.servingCertFile, cert.NotBefore)
+	}
+	if now.After(cert.NotAfter) {
+		return false, fmt.Errorf("serving certificate at %q expired, NotAfter=%s", sm.servingCertFile, cert.NotAfter)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,101 @@
+package monitor
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/p0lyn0mial/startup-monitor/monitor/monitortesting"
+)
+
+// maxChaosAttempts bounds how many times a chaos test retries fallbackToPreviousRevision before
+// giving up, mirroring the outer sync loop, which keeps calling fallbackToPreviousRevision on
+// every probeInterval tick until it succeeds or the process is stopped.
+const maxChaosAttempts = 200
+
+// runFallbackUnderFaults retries target.fallbackToPreviousRevision, exactly as the outer sync
+// loop would across probeInterval ticks, until it succeeds or maxChaosAttempts is exhausted, and
+// fails t if it never converges or if it panics.
+func runFallbackUnderFaults(t *testing.T, target *StartupMonitor) {
+	t.Helper()
+
+	for attempt := 0; attempt < maxChaosAttempts; attempt++ {
+		if err := target.fallbackToPreviousRevision(""); err == nil {
+			return
+		}
+	}
+	t.Fatalf("fallback did not converge to a safe state within %d attempts", maxChaosAttempts)
+}
+
+// assertFellBackToRevision reads target's root manifest and fails t unless it parses cleanly
+// and carries expectedRevision, guarding against a fault pattern leaving behind a corrupt or
+// half-written manifest instead of either the original or a complete rollback.
+func assertFellBackToRevision(t *testing.T, target *StartupMonitor, expectedRevision int) {
+	t.Helper()
+
+	rolledBackPod, err := target.readTargetPod(target.rootManifestPath())
+	if err != nil {
+		t.Fatalf("root manifest is not a valid pod after fallback: %v", err)
+	}
+	revision, err := revisionOfPod(rolledBackPod)
+	if err != nil {
+		t.Fatalf("root manifest doesn't carry a valid revision after fallback: %v", err)
+	}
+	if revision != expectedRevision {
+		t.Errorf("unexpected revision %d after fallback, expected %d", revision, expectedRevision)
+	}
+}
+
+// newChaosTarget builds a StartupMonitor against realFS and t.TempDir, seeded with revisions 9
+// (current, broken) and 8 (previous, to fall back to), with its io wrapped in a
+// FaultInjectingIO configured per cfg.
+func newChaosTarget(t *testing.T, cfg monitortesting.FaultConfig) *StartupMonitor {
+	t.Helper()
+
+	target := newIntegrationTarget(t, 9)
+	writeIntegrationRevision(t, target, 8)
+	writeIntegrationRevision(t, target, 9)
+	target.io = monitortesting.NewFaultInjectingIO(target.io, cfg)
+	return target
+}
+
+func TestChaosFallbackConvergesUnderTransientErrors(t *testing.T) {
+	target := newChaosTarget(t, monitortesting.FaultConfig{
+		ErrorRate: 0.3,
+		Rand:      rand.New(rand.NewSource(1)),
+	})
+
+	runFallbackUnderFaults(t, target)
+	assertFellBackToRevision(t, target, 8)
+}
+
+func TestChaosFallbackConvergesUnderNotExistFlaps(t *testing.T) {
+	target := newChaosTarget(t, monitortesting.FaultConfig{
+		NotExistFlapRate: 0.3,
+		Rand:             rand.New(rand.NewSource(2)),
+	})
+
+	runFallbackUnderFaults(t, target)
+	assertFellBackToRevision(t, target, 8)
+}
+
+func TestChaosFallbackConvergesUnderPartialWrites(t *testing.T) {
+	target := newChaosTarget(t, monitortesting.FaultConfig{
+		PartialWriteRate: 0.3,
+		Rand:             rand.New(rand.NewSource(3)),
+	})
+
+	runFallbackUnderFaults(t, target)
+	assertFellBackToRevision(t, target, 8)
+}
+
+func TestChaosFallbackConvergesUnderCombinedFaults(t *testing.T) {
+	target := newChaosTarget(t, monitortesting.FaultConfig{
+		ErrorRate:        0.15,
+		NotExistFlapRate: 0.1,
+		PartialWriteRate: 0.1,
+		Rand:             rand.New(rand.NewSource(4)),
+	})
+
+	runFallbackUnderFaults(t, target)
+	assertFellBackToRevision(t, target, 8)
+}
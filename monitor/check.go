@@ -0,0 +1,72 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// CheckOptions configures the "check" subcommand.
+type CheckOptions struct {
+	// TargetName names the operand being checked, e.g. "kube-apiserver", and is only used to
+	// make the printed result readable.
+	TargetName string
+	// HealthCheckName resolves a HealthChecker by name from the registry via
+	// healthCheckerForName, the same registry --health-check consults on the main command.
+	HealthCheckName string
+	// Timeout bounds the single probe, the same role sm.probeInterval plays for probeHealth.
+	Timeout time.Duration
+}
+
+// RunCheck runs a single health probe against opts.HealthCheckName and reports whether it's
+// healthy, its reason if unhealthy, and an error only if the check itself failed to run.
+func RunCheck(ctx context.Context, opts CheckOptions) (healthy bool, reason string, err error) {
+	healthChecker, err := healthCheckerForName(opts.HealthCheckName)
+	if err != nil {
+		return false, "", err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
+	defer cancel()
+
+	return healthChecker.Check(ctx)
+}
+
+// newCheckCommand builds the "check" subcommand, which runs a single health probe against the
+// target and exits 0/1 with a human-readable reason, without touching any manifests. It's meant
+// for debugging probe configuration from a node shell and for wiring into must-gather scripts.
+func newCheckCommand() *cobra.Command {
+	opts := CheckOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "check",
+		Short: "Runs a single health probe against the target and reports whether it's healthy.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			healthy, reason, err := RunCheck(cmd.Context(), opts)
+			if err != nil {
+				return fmt.Errorf("health check failed to run: %v", err)
+			}
+			if !healthy {
+				if reason != "" {
+					fmt.Printf("%s is unhealthy: %s\n", opts.TargetName, reason)
+				} else {
+					fmt.Printf("%s is unhealthy\n", opts.TargetName)
+				}
+				return fmt.Errorf("%s is unhealthy", opts.TargetName)
+			}
+			fmt.Printf("%s is healthy\n", opts.TargetName)
+			return nil
+		},
+	}
+
+	fs := cmd.Flags()
+	fs.StringVar(&opts.TargetName, "target-name", "", "name of the operand, e.g. \"kube-apiserver\" (required)")
+	fs.StringVar(&opts.HealthCheckName, "health-check", "", "name of a health checker registered via RegisterHealthChecker to run, e.g. \"kube-apiserver-readyz\" (required)")
+	fs.DurationVar(&opts.Timeout, "timeout", 5*time.Second, "how long to wait for the health checker to respond")
+	_ = cmd.MarkFlagRequired("target-name")
+	_ = cmd.MarkFlagRequired("health-check")
+
+	return cmd
+}
@@ -0,0 +1,103 @@
+package monitor
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/clock"
+)
+
+// TestFallbackDeadlineViaFakeClock steps a fake clock across the fallback deadline and verifies
+// the remaining-budget check sync uses transitions from "still waiting" to "timed out" exactly
+// at the timeout, without any real sleeping.
+func TestFallbackDeadlineViaFakeClock(t *testing.T) {
+	fakeClock := clock.NewFakeClock(time.Unix(0, 0))
+	target := New(nil).WithClockForTesting(fakeClock)
+	target.timeout = 2 * time.Minute
+
+	monitorTimeStamp := target.recordMonitorStart()
+
+	remainingBudget := func() time.Duration {
+		return target.timeout - target.clock.Since(monitorTimeStamp)
+	}
+
+	if remainingBudget() <= 0 {
+		t.Fatalf("expected budget to remain right after recordMonitorStart")
+	}
+
+	fakeClock.Step(90 * time.Second)
+	if remainingBudget() <= 0 {
+		t.Fatalf("expected budget to remain with 30s left before the timeout")
+	}
+
+	fakeClock.Step(29 * time.Second)
+	if remainingBudget() <= 0 {
+		t.Fatalf("expected budget to remain with 1s left before the timeout")
+	}
+
+	fakeClock.Step(time.Second)
+	if remainingBudget() > 0 {
+		t.Errorf("expected the timeout to have been reached exactly at the deadline")
+	}
+}
+
+// TestExtendDeadlineStabilizationWindowViaFakeClock steps a fake clock to verify extendDeadline
+// pushes the deadline out by sm.timeout each time it is called, up to maxRolloutExtensions,
+// after which the stabilization window is exhausted and further extensions are refused.
+func TestExtendDeadlineStabilizationWindowViaFakeClock(t *testing.T) {
+	fakeClock := clock.NewFakeClock(time.Unix(0, 0))
+	target := New(nil).WithClockForTesting(fakeClock)
+	target.timeout = time.Minute
+	target.maxRolloutExtensions = 2
+
+	initial := target.recordMonitorStart()
+	fakeClock.Step(target.timeout)
+	if remaining := target.timeout - target.clock.Since(initial); remaining > 0 {
+		t.Fatalf("expected the initial deadline to have elapsed before extending it")
+	}
+
+	if !target.extendDeadline() {
+		t.Fatalf("expected the first extension to be granted")
+	}
+	firstExtension := target.monitorTimeStamp
+	if !firstExtension.Equal(fakeClock.Now()) {
+		t.Errorf("expected the deadline to be reset to the current fake time, got %s, expected %s", firstExtension, fakeClock.Now())
+	}
+
+	fakeClock.Step(target.timeout)
+	if !target.extendDeadline() {
+		t.Fatalf("expected the second extension to be granted")
+	}
+
+	fakeClock.Step(target.timeout)
+	if target.extendDeadline() {
+		t.Errorf("expected the third extension to be refused once maxRolloutExtensions is reached")
+	}
+	if target.rolloutExtensions != target.maxRolloutExtensions {
+		t.Errorf("unexpected rolloutExtensions %d, expected %d", target.rolloutExtensions, target.maxRolloutExtensions)
+	}
+}
+
+// TestPauseCountdownHoldoffViaFakeClock steps a fake clock to verify pauseCountdown resets the
+// deadline to the current time on every call, with no cap, so a holdoff period (e.g. a dead
+// kubelet or an in-progress machine config update) never lets the fallback budget run out
+// through no fault of the operand.
+func TestPauseCountdownHoldoffViaFakeClock(t *testing.T) {
+	fakeClock := clock.NewFakeClock(time.Unix(0, 0))
+	target := New(nil).WithClockForTesting(fakeClock)
+	target.timeout = 30 * time.Second
+
+	initial := target.recordMonitorStart()
+
+	for i := 0; i < 5; i++ {
+		fakeClock.Step(target.timeout)
+		target.pauseCountdown()
+		if remaining := target.timeout - target.clock.Since(target.monitorTimeStamp); remaining <= 0 {
+			t.Fatalf("iteration %d: expected the holdoff to reset the budget, got a non-positive remaining budget", i)
+		}
+	}
+
+	if !target.monitorTimeStamp.After(initial) {
+		t.Errorf("expected the deadline to have moved past the original recordMonitorStart timestamp")
+	}
+}
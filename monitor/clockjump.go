@@ -0,0 +1,39 @@
+package monitor
+
+import (
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+// clockJumpThreshold is how far the wall-clock delta between two sync ticks may diverge from
+// their monotonic delta before it's logged as a clock jump (NTP step, suspend/resume, or a
+// hardware clock correction).
+const clockJumpThreshold = 30 * time.Second
+
+// detectClockJump compares the wall-clock delta between previous and current against their
+// monotonic delta and logs a warning if they diverge by more than clockJumpThreshold.
+//
+// The monitor's own deadline math computes the remaining fallback budget as
+// sm.timeout - time.Since(monitorTimeStamp), a duration decremented by elapsed monotonic
+// time rather than an absolute wall-clock target compared against time.Now(). time.Since,
+// like Add, Sub, Before and After, uses the monotonic reading embedded in a time.Time by
+// time.Now whenever both operands carry one. A wall-clock jump therefore can neither trigger
+// an instant fallback nor indefinitely postpone one. This check exists purely to surface the
+// jump for operators, since it can still confuse everything else that reads the wall clock,
+// such as log timestamps and fallback record ConfigMaps.
+func detectClockJump(previous, current time.Time) {
+	if previous.IsZero() {
+		return
+	}
+
+	monotonicDelta := current.Sub(previous)
+	wallDelta := current.Round(0).Sub(previous.Round(0))
+	drift := wallDelta - monotonicDelta
+	if drift < 0 {
+		drift = -drift
+	}
+	if drift > clockJumpThreshold {
+		klog.Warningf("Detected a %s wall-clock jump relative to the monotonic clock (NTP step, suspend/resume, or a hardware clock correction); the fallback deadline is unaffected since it is anchored to the monotonic clock", drift)
+	}
+}
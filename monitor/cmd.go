@@ -3,65 +3,573 @@ package monitor
 import (
 	"context"
 	"fmt"
+	"os"
 	"time"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	"sigs.k8s.io/yaml"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/klog/v2"
 )
 
 type StartupMonitorOptions struct {
+	// TargetName hold the name of the operand, used to construct manifest file names, e.g.
+	// "kube-apiserver". Populated from --target-name or --config.
+	TargetName string
+
+	// ManifestsPath points to the directory that holds the root manifests. Populated from
+	// --manifests-dir or --config.
+	ManifestsPath string
+
+	// StaticPodResourcesPath points to the directory that holds revisioned manifests.
+	// Populated from --resource-dir or --config.
+	StaticPodResourcesPath string
+
+	// Revision is the revision the monitor is guarding. Populated from --revision or --config.
+	Revision int
+
+	// ProbeInterval specifies a time interval at which health of the target will be assessed.
+	// Be mindful of not setting it too low, on each iteration, an i/o is involved. Populated
+	// from --probe-interval or --config.
+	ProbeInterval time.Duration
+
 	// FallbackTimeout specifies a timeout after which the monitor starts the fall back procedure
 	FallbackTimeout time.Duration
 
-	// IsTargetHealthy defines a function that abstracts away assessing operand's health condition.
-	// This is the extention point for the operators to provide a custom health function for their operands
-	IsTargetHealthy func() bool
+	// ConfigFile, if set, points to a YAML or JSON file populating TargetName, ManifestsPath,
+	// StaticPodResourcesPath, Revision, ProbeInterval, FallbackTimeout and HealthCheckName. Any
+	// flag also passed on the command line overrides the same field set in the file, so a
+	// rendered config can still be tweaked ad hoc without editing it. See
+	// StartupMonitorFileConfig.
+	ConfigFile string
+
+	// HealthChecker abstracts away assessing operand's health condition. This is the extention
+	// point for the operators to provide a custom health check for their operands. It can also
+	// be selected by name at runtime via --health-check; see RegisterHealthChecker.
+	HealthChecker HealthChecker
+
+	// HealthCheckName, when set, resolves a HealthChecker by name from the registry via
+	// --health-check, overriding whatever was set via WithHealthCheck. Lets an operand reuse
+	// this binary without recompiling it with a custom health check.
+	HealthCheckName string
+
+	// Kubeconfig points to a kubeconfig file used to build an optional Kubernetes client.
+	// When empty, an in-cluster config is attempted; if that also fails the monitor runs
+	// without a client, offline, with API-backed features disabled.
+	Kubeconfig string
+
+	// OperatorResource identifies the singleton operator.openshift.io resource (e.g.
+	// kubeapiservers.operator.openshift.io) to patch with a fallback condition. Left zero,
+	// the fallback condition is not reported.
+	OperatorResource schema.GroupVersionResource
+
+	// ServingCertFile and ServingKeyFile locate the operand's serving certificate/key pair on
+	// disk. When both are set, the monitor validates the pair as an additional health signal
+	// alongside IsTargetHealthy; see WithServingCertificate. Left empty, certificate
+	// validation is disabled.
+	ServingCertFile string
+	ServingKeyFile  string
+
+	// FallbackGuards are consulted before falling back to the previous revision, in addition
+	// to the operand's health check. Any guard that vetoes the fallback aborts it. See
+	// WithFallbackGuard.
+	FallbackGuards []FallbackGuardFunc
+
+	// LayoutName selects the directory scheme used to locate manifests: "openshift" (the
+	// default, revisioned resource directories under staticPodResourcesPath) or "kubeadm" (a
+	// flat manifests directory with no revision directories). See WithLayout and
+	// layoutForName.
+	LayoutName string
+
+	// InstallerLockFilePath, if set, enables coordinating with a concurrent installer pod. See
+	// StartupMonitor.WithInstallerLockFilePath.
+	InstallerLockFilePath string
+
+	// InstallerLockTimeout overrides how long sync waits to acquire InstallerLockFilePath
+	// before giving up on that tick. Only meaningful when InstallerLockFilePath is also set.
+	InstallerLockTimeout time.Duration
+
+	// ReportNodeState enables patching this node's object with a JSON-encoded NodeState
+	// annotation as the monitor's assessment of the target changes. See
+	// StartupMonitor.WithNodeStateReporting. Requires --kubeconfig (or in-cluster config) to
+	// resolve a client; without one, this has no effect.
+	ReportNodeState bool
+
+	// StateFileDir, if set, enables writing a machine-readable JSON state file describing the
+	// monitor's current state for its target into this directory on every phase transition.
+	// See StartupMonitor.WithStateFileDir.
+	StateFileDir string
+
+	// AnnotationPrefix overrides the annotation domain prefix applied to the fallback pod's
+	// manifest. See StartupMonitor.WithAnnotationPrefix.
+	AnnotationPrefix string
+
+	// TerminationMessagePath is where a fatal watchdog exit writes a concise summary of why
+	// the process is terminating, so `kubectl describe pod` surfaces it without needing log
+	// access. Defaults to /dev/termination-log, the path the kubelet watches for every
+	// container by convention. See StartupMonitor.WithTerminationMessagePath.
+	TerminationMessagePath string
+
+	// PinnedFallbackRevision, if set, overrides the last-known-good/N-1 heuristic and forces
+	// fallback to use exactly this revision instead. See StartupMonitor.WithPinnedFallbackRevision.
+	PinnedFallbackRevision int
+
+	// KeepRunning, once the target is observed healthy, makes the monitor idle in a low-cost
+	// sleep state instead of removing its own manifest, for operators that manage the monitor's
+	// pod lifecycle externally rather than relying on it to remove itself. See
+	// StartupMonitor.WithSleepMode.
+	KeepRunning bool
+
+	// LogFormat selects how log lines are rendered: "text" (the default, klog's usual
+	// human-readable format) or "json" (one JSON object per line, for consumption by log
+	// aggregators). Populated from --log-format.
+	LogFormat string
+
+	// ExecCommand, when --health-check=exec is selected, is run through "sh -c" on every probe;
+	// exit code 0 is reported healthy, any other exit code (or a timeout) is reported unhealthy.
+	// It's the escape hatch for operands without an HTTP health endpoint, e.g. "etcdctl endpoint
+	// health" or "oc get --raw /readyz". Populated from --exec-command.
+	ExecCommand string
+
+	// ExecTimeout bounds how long ExecCommand is allowed to run before it's killed and the probe
+	// reported unhealthy. Populated from --exec-command-timeout.
+	ExecTimeout time.Duration
+
+	// Address, when --health-check=tcp is selected, is the "host:port" a TCP connection is
+	// attempted against on every probe. Populated from --address.
+	Address string
+
+	// AddressTimeout bounds how long a --health-check=tcp connection attempt is allowed to take
+	// before it's reported unhealthy. Populated from --address-timeout.
+	AddressTimeout time.Duration
+
+	// KubeletPodsPort, when --health-check=kubelet-pods is selected, is the port the local
+	// kubelet's secure /pods endpoint is queried on. Populated from --kubelet-pods-port.
+	KubeletPodsPort int
+
+	// KubeletPodsNamespace, when --health-check=kubelet-pods is selected, is the namespace the
+	// target's mirror pod is looked up in. Populated from --kubelet-pods-namespace.
+	KubeletPodsNamespace string
+
+	// KubeletPodsMaxRestarts, when --health-check=kubelet-pods is selected, is the maximum
+	// number of container restarts the mirror pod may have before it's reported unhealthy. A
+	// negative value disables the restart-count check. Populated from
+	// --kubelet-pods-max-restarts.
+	KubeletPodsMaxRestarts int
+
+	// addExtraFlags, when set, lets an operator register additional flags on the command's FlagSet
+	addExtraFlags func(*pflag.FlagSet)
 }
 
-func NewStartupMonitorCommand() *cobra.Command {
-	o := StartupMonitorOptions{}
+// Option customizes a StartupMonitorOptions produced by NewStartupMonitorCommand.
+// Operators (kube-apiserver, etcd, KCM, ...) use options to plug in their own
+// health check, extra flags and target-specific defaults.
+type Option func(*StartupMonitorOptions)
+
+// WithHealthCheck sets the HealthChecker used to assess the operand's health condition.
+func WithHealthCheck(healthChecker HealthChecker) Option {
+	return func(o *StartupMonitorOptions) {
+		o.HealthChecker = healthChecker
+	}
+}
+
+// WithFallbackTimeoutDefault overrides the default value of --fallback-timeout-duration.
+func WithFallbackTimeoutDefault(timeout time.Duration) Option {
+	return func(o *StartupMonitorOptions) {
+		o.FallbackTimeout = timeout
+	}
+}
+
+// WithServingCertificateDefault overrides the default value of --serving-cert-file and
+// --serving-key-file.
+func WithServingCertificateDefault(certFile, keyFile string) Option {
+	return func(o *StartupMonitorOptions) {
+		o.ServingCertFile = certFile
+		o.ServingKeyFile = keyFile
+	}
+}
+
+// WithFallbackGuardDefault registers a function consulted before falling back to the previous
+// revision, in addition to the operand's health check. It may be passed multiple times to
+// register several guards; see StartupMonitor.WithFallbackGuard.
+func WithFallbackGuardDefault(fn FallbackGuardFunc) Option {
+	return func(o *StartupMonitorOptions) {
+		o.FallbackGuards = append(o.FallbackGuards, fn)
+	}
+}
+
+// WithOperatorResourceDefault sets the operator.openshift.io resource to patch with a
+// fallback condition, e.g. schema.GroupVersionResource{Group: "operator.openshift.io", Version: "v1", Resource: "kubeapiservers"}.
+func WithOperatorResourceDefault(resource schema.GroupVersionResource) Option {
+	return func(o *StartupMonitorOptions) {
+		o.OperatorResource = resource
+	}
+}
+
+// WithInstallerLockFileDefault overrides the default value of --installer-lock-file-path.
+func WithInstallerLockFileDefault(path string) Option {
+	return func(o *StartupMonitorOptions) {
+		o.InstallerLockFilePath = path
+	}
+}
+
+// WithExtraFlags lets an operator register additional flags on the command's FlagSet,
+// for example to expose target-specific configuration.
+func WithExtraFlags(addFlags func(*pflag.FlagSet)) Option {
+	return func(o *StartupMonitorOptions) {
+		o.addExtraFlags = addFlags
+	}
+}
+
+// NewStartupMonitorCommand builds the "startup-monitor" cobra command shared by every
+// operator. Each operator (kube-apiserver, etcd, KCM) supplies its own health check,
+// extra flags and defaults via opts and ships the resulting command as its own subcommand.
+func NewStartupMonitorCommand(opts ...Option) *cobra.Command {
+	o := StartupMonitorOptions{
+		FallbackTimeout:        120 * time.Second,
+		ManifestsPath:          "/etc/kubernetes/manifests",
+		StaticPodResourcesPath: "/etc/kubernetes/static-pod-resources",
+		ProbeInterval:          time.Second,
+		ExecTimeout:            5 * time.Second,
+		AddressTimeout:         5 * time.Second,
+		KubeletPodsPort:        10250,
+		KubeletPodsMaxRestarts: -1,
+		TerminationMessagePath: defaultTerminationMessagePath,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
 
 	cmd := &cobra.Command{
 		Use:   "startup-monitor",
 		Short: "Monitors the provided static pod revision and if it proves unhealthy rolls back to the previous revision.",
 		Run: func(cmd *cobra.Command, args []string) {
 			klog.V(1).Info(cmd.Flags())
+
+			if o.ConfigFile != "" {
+				fileConfig, err := LoadStartupMonitorFileConfig(o.ConfigFile)
+				if err != nil {
+					o.exit(err)
+				}
+				o.applyFileConfig(fileConfig, cmd.Flags())
+			}
+
 			klog.V(1).Info(spew.Sdump(o))
 
 			if err := o.Validate(); err != nil {
-				klog.Exit(err)
+				o.exit(err)
 			}
 
-			o.Run()
+			if err := o.Run(); err != nil {
+				o.exit(err)
+			}
 		},
 	}
 
 	o.AddFlags(cmd.Flags())
+	if o.addExtraFlags != nil {
+		o.addExtraFlags(cmd.Flags())
+	}
+
+	cmd.AddCommand(newSimulateCommand())
+	cmd.AddCommand(newDoctorCommand())
+	cmd.AddCommand(newCheckCommand())
+	cmd.AddCommand(newFallbackCommand())
 
 	return cmd
 }
 
 func (o *StartupMonitorOptions) AddFlags(fs *pflag.FlagSet) {
-	fs.DurationVar(&o.FallbackTimeout, "fallback-timeout-duration", 120*time.Second, "maximum time in seconds to wait for the operand to become healthy (default: 2m)")
+	fs.StringVar(&o.TargetName, "target-name", o.TargetName, "name of the operand, used to construct manifest file names, e.g. \"kube-apiserver\"")
+	fs.StringVar(&o.ManifestsPath, "manifests-dir", o.ManifestsPath, "path to the directory that holds the root manifests")
+	fs.StringVar(&o.StaticPodResourcesPath, "resource-dir", o.StaticPodResourcesPath, "path to the directory that holds revisioned manifests")
+	fs.IntVar(&o.Revision, "revision", o.Revision, "revision at which the monitor was started")
+	fs.DurationVar(&o.ProbeInterval, "probe-interval", o.ProbeInterval, "time interval at which health of the target is assessed")
+	fs.StringVar(&o.ConfigFile, "config", o.ConfigFile, "path to a YAML or JSON file populating target name, manifests path, static-pod-resources path, revision, probe interval, fallback timeout and health-check settings; any flag passed on the command line overrides the same field set in the file")
+	fs.DurationVar(&o.FallbackTimeout, "fallback-timeout-duration", o.FallbackTimeout, "maximum time in seconds to wait for the operand to become healthy (default: 2m)")
+	fs.StringVar(&o.Kubeconfig, "kubeconfig", o.Kubeconfig, "path to a kubeconfig file. If unset, an in-cluster config is attempted; if that also fails the monitor runs offline, with API-backed features disabled.")
+	fs.StringVar(&o.ServingCertFile, "serving-cert-file", o.ServingCertFile, "path to the operand's serving certificate. If unset, along with --serving-key-file, certificate validation is disabled.")
+	fs.StringVar(&o.ServingKeyFile, "serving-key-file", o.ServingKeyFile, "path to the operand's serving key. If unset, along with --serving-cert-file, certificate validation is disabled.")
+	fs.StringVar(&o.LayoutName, "layout", o.LayoutName, "directory scheme used to locate manifests: \"openshift\" (default, revisioned resource directories) or \"kubeadm\" (a flat manifests directory with no revision directories)")
+	fs.StringVar(&o.HealthCheckName, "health-check", o.HealthCheckName, "name of a health checker registered via RegisterHealthChecker to use instead of the one set with WithHealthCheck, e.g. \"kube-apiserver-readyz\", \"exec\" to run --exec-command, \"tcp\" to dial --address, or \"kubelet-pods\" to query the local kubelet's /pods endpoint")
+	fs.StringVar(&o.ExecCommand, "exec-command", o.ExecCommand, "command run through \"sh -c\" on every probe when --health-check=exec is selected; exit code 0 is reported healthy")
+	fs.DurationVar(&o.ExecTimeout, "exec-command-timeout", o.ExecTimeout, "maximum time --exec-command is allowed to run before it's killed and the probe is reported unhealthy")
+	fs.StringVar(&o.Address, "address", o.Address, "\"host:port\" a TCP connection is attempted against on every probe when --health-check=tcp is selected")
+	fs.DurationVar(&o.AddressTimeout, "address-timeout", o.AddressTimeout, "maximum time a --health-check=tcp connection attempt is allowed to take before it's reported unhealthy")
+	fs.IntVar(&o.KubeletPodsPort, "kubelet-pods-port", o.KubeletPodsPort, "port the local kubelet's secure /pods endpoint is queried on when --health-check=kubelet-pods is selected")
+	fs.StringVar(&o.KubeletPodsNamespace, "kubelet-pods-namespace", o.KubeletPodsNamespace, "namespace the target's mirror pod is looked up in when --health-check=kubelet-pods is selected")
+	fs.IntVar(&o.KubeletPodsMaxRestarts, "kubelet-pods-max-restarts", o.KubeletPodsMaxRestarts, "maximum number of container restarts the mirror pod may have before it's reported unhealthy when --health-check=kubelet-pods is selected; negative disables the check")
+	fs.StringVar(&o.InstallerLockFilePath, "installer-lock-file-path", o.InstallerLockFilePath, "path to a lock file used to coordinate with a concurrent installer pod, e.g. \"/var/lock/kube-apiserver-installer.lock\". If unset, no locking is performed.")
+	fs.DurationVar(&o.InstallerLockTimeout, "installer-lock-timeout", o.InstallerLockTimeout, "maximum time to wait to acquire --installer-lock-file-path before giving up on a sync iteration")
+	fs.BoolVar(&o.ReportNodeState, "report-node-state", o.ReportNodeState, "patch this node's object with a JSON-encoded state annotation as the monitor's assessment of the target changes. Requires --kubeconfig (or an in-cluster config) to resolve a client.")
+	fs.StringVar(&o.StateFileDir, "state-file-dir", o.StateFileDir, "directory to write a JSON state file describing the monitor's assessment of the target into, on every phase transition, e.g. \"/var/lib/startup-monitor\". The directory must already exist. If unset, no file is written.")
+	fs.StringVar(&o.TerminationMessagePath, "termination-message-path", o.TerminationMessagePath, "path to write a concise summary of a fatal exit to, so `kubectl describe pod` surfaces it without needing log access. If empty, no termination message is written.")
+	fs.StringVar(&o.AnnotationPrefix, "annotation-prefix", o.AnnotationPrefix, "annotation domain prefix applied to the fallback pod's manifest. If unset, defaults to \"startup-monitor.static-pods.openshift.io\".")
+	fs.IntVar(&o.PinnedFallbackRevision, "fallback-to-revision", o.PinnedFallbackRevision, "if set, overrides the last-known-good/N-1 heuristic and forces fallback to use exactly this revision. The revision must have a manifest on disk. If unset, the default, the heuristic is used.")
+	fs.BoolVar(&o.KeepRunning, "keep-running", o.KeepRunning, "once the target is observed healthy, idle instead of removing the monitor's own manifest, for operators that manage the monitor's pod lifecycle externally")
+	fs.StringVar(&o.LogFormat, "log-format", o.LogFormat, "how log lines are rendered: \"text\" (default, klog's usual human-readable format) or \"json\" (one JSON object per line)")
+}
+
+// logFormatForName validates a --log-format value.
+func logFormatForName(name string) error {
+	switch name {
+	case "", "text", "json":
+		return nil
+	default:
+		return fmt.Errorf("unknown --log-format %q, must be one of: text, json", name)
+	}
+}
+
+// layoutForName resolves a --layout value to the Layout it selects.
+func layoutForName(name string) (Layout, error) {
+	switch name {
+	case "", "openshift":
+		return openshiftLayout{}, nil
+	case "kubeadm":
+		return kubeadmLayout{}, nil
+	default:
+		return nil, fmt.Errorf("unknown --layout %q, must be one of: openshift, kubeadm", name)
+	}
+}
+
+// exit writes err's message as the termination message before calling klog.Exit(err), so a
+// fatal startup-monitor error survives past the pod's logs into `kubectl describe pod`.
+func (o *StartupMonitorOptions) exit(err error) {
+	writeTerminationMessage(o.TerminationMessagePath, err.Error())
+	klog.Exit(err)
 }
 
 func (o *StartupMonitorOptions) Validate() error {
+	if len(o.TargetName) == 0 {
+		return fmt.Errorf("--target-name (or targetName in --config) cannot be empty")
+	}
+	if len(o.ManifestsPath) == 0 {
+		return fmt.Errorf("--manifests-dir cannot be empty")
+	}
+	if len(o.StaticPodResourcesPath) == 0 {
+		return fmt.Errorf("--resource-dir cannot be empty")
+	}
+	if o.Revision < 0 {
+		return fmt.Errorf("--revision cannot be negative, got %d", o.Revision)
+	}
+	if o.PinnedFallbackRevision < 0 {
+		return fmt.Errorf("--fallback-to-revision cannot be negative, got %d", o.PinnedFallbackRevision)
+	}
+	if o.ProbeInterval <= 0 {
+		return fmt.Errorf("--probe-interval must be greater than 0")
+	}
 	if o.FallbackTimeout == 0 {
 		return fmt.Errorf("--fallback-timeout-duration cannot be 0")
 	}
+	if o.ProbeInterval >= o.FallbackTimeout {
+		return fmt.Errorf("--probe-interval (%s) must be less than --fallback-timeout-duration (%s)", o.ProbeInterval, o.FallbackTimeout)
+	}
+	if o.HealthChecker == nil && len(o.HealthCheckName) == 0 {
+		return fmt.Errorf("a health checker must be provided, see WithHealthCheck or --health-check")
+	}
+	if o.HealthCheckName == "exec" {
+		if len(o.ExecCommand) == 0 {
+			return fmt.Errorf("--exec-command cannot be empty when --health-check=exec")
+		}
+		if o.ExecTimeout <= 0 {
+			return fmt.Errorf("--exec-command-timeout must be greater than 0")
+		}
+	} else if o.HealthCheckName == "tcp" {
+		if len(o.Address) == 0 {
+			return fmt.Errorf("--address cannot be empty when --health-check=tcp")
+		}
+		if o.AddressTimeout <= 0 {
+			return fmt.Errorf("--address-timeout must be greater than 0")
+		}
+	} else if o.HealthCheckName == "kubelet-pods" {
+		if len(o.KubeletPodsNamespace) == 0 {
+			return fmt.Errorf("--kubelet-pods-namespace cannot be empty when --health-check=kubelet-pods")
+		}
+	} else if len(o.HealthCheckName) > 0 {
+		if _, err := healthCheckerForName(o.HealthCheckName); err != nil {
+			return err
+		}
+	}
+	if _, err := layoutForName(o.LayoutName); err != nil {
+		return err
+	}
+	if err := logFormatForName(o.LogFormat); err != nil {
+		return err
+	}
 	return nil
 }
 
-func (o *StartupMonitorOptions) Run() {
-	shutdownCtx := SetupSignalContext(context.TODO())
+func (o *StartupMonitorOptions) Run() error {
+	if o.LogFormat == "json" {
+		klog.SetLogger(newJSONLogger(os.Stderr))
+	}
+
+	shutdownCtx, cleanupSignalContext := SetupSignalContext(context.TODO())
+	defer cleanupSignalContext()
+
+	healthChecker := o.HealthChecker
+	if o.HealthCheckName == "exec" {
+		healthChecker = execHealthChecker(o.ExecCommand, o.ExecTimeout)
+	} else if o.HealthCheckName == "tcp" {
+		healthChecker = tcpHealthChecker(o.Address, o.AddressTimeout)
+	} else if o.HealthCheckName == "kubelet-pods" {
+		healthChecker = kubeletPodsHealthChecker(o.KubeletPodsPort, o.TargetName, o.KubeletPodsNamespace, o.KubeletPodsMaxRestarts)
+	} else if len(o.HealthCheckName) > 0 {
+		if resolved, err := healthCheckerForName(o.HealthCheckName); err != nil {
+			// already validated in Validate; only reachable if Run is called directly.
+			o.exit(fmt.Errorf("invalid --health-check: %v", err))
+		} else {
+			healthChecker = resolved
+		}
+	}
 
 	// start monitor
-	sm := New(nil).
+	sm := New(healthChecker).
 		WithProbeTimeout(o.FallbackTimeout).
-		WithProbeInterval(time.Second)
+		WithProbeInterval(o.ProbeInterval)
+	sm.targetName = o.TargetName
+	sm.manifestsPath = o.ManifestsPath
+	sm.staticPodResourcesPath = o.StaticPodResourcesPath
+	sm.revision = o.Revision
+
+	if o.ServingCertFile != "" && o.ServingKeyFile != "" {
+		sm = sm.WithServingCertificate(o.ServingCertFile, o.ServingKeyFile)
+	}
+
+	if o.InstallerLockFilePath != "" {
+		sm = sm.WithInstallerLockFilePath(o.InstallerLockFilePath)
+		if o.InstallerLockTimeout != 0 {
+			sm = sm.WithInstallerLockTimeout(o.InstallerLockTimeout)
+		}
+	}
+
+	if o.StateFileDir != "" {
+		sm = sm.WithStateFileDir(o.StateFileDir)
+	}
+
+	sm = sm.WithTerminationMessagePath(o.TerminationMessagePath)
+
+	if o.AnnotationPrefix != "" {
+		sm = sm.WithAnnotationPrefix(o.AnnotationPrefix)
+	}
+
+	if o.PinnedFallbackRevision != 0 {
+		sm = sm.WithPinnedFallbackRevision(o.PinnedFallbackRevision)
+	}
+
+	if o.KeepRunning {
+		sm = sm.WithSleepMode()
+	}
 
-	sm.Run(shutdownCtx)
+	if layout, err := layoutForName(o.LayoutName); err != nil {
+		// already validated in Validate; only reachable if Run is called directly.
+		o.exit(fmt.Errorf("invalid layout: %v", err))
+	} else {
+		sm = sm.WithLayout(layout)
+	}
+
+	for _, guard := range o.FallbackGuards {
+		sm = sm.WithFallbackGuard(guard)
+	}
+
+	restConfig, err := clientcmd.BuildConfigFromFlags("", o.Kubeconfig)
+	if err != nil {
+		klog.Warningf("Running without a Kubernetes client, API-backed features (events, status updates, node checks) are disabled: %v", err)
+		return sm.Run(shutdownCtx)
+	}
+
+	if client, err := kubernetes.NewForConfig(restConfig); err != nil {
+		klog.Warningf("Running without a Kubernetes client, API-backed features (events, status updates, node checks) are disabled: %v", err)
+	} else {
+		sm = sm.WithClient(client)
+		if o.ReportNodeState {
+			sm = sm.WithNodeStateReporting()
+		}
+	}
+
+	if !o.OperatorResource.Empty() {
+		if dynamicClient, err := dynamic.NewForConfig(restConfig); err != nil {
+			klog.Warningf("Unable to build a dynamic client, the operator fallback condition won't be reported: %v", err)
+		} else {
+			sm = sm.WithDynamicClient(dynamicClient).WithOperatorResource(o.OperatorResource)
+		}
+	}
+
+	return sm.Run(shutdownCtx)
+}
+
+// StartupMonitorFileConfig is the schema accepted by --config: a YAML or JSON file populating a
+// subset of StartupMonitorOptions, so operators that render the startup-monitor static pod via
+// templates can ship one config file instead of a long argument list. Every field is optional
+// and mirrors an existing flag; a flag passed on the command line always overrides the same
+// field set in the file.
+type StartupMonitorFileConfig struct {
+	// TargetName mirrors --target-name.
+	TargetName string `json:"targetName,omitempty"`
+
+	// ManifestsPath mirrors --manifests-dir.
+	ManifestsPath string `json:"manifestsPath,omitempty"`
+
+	// StaticPodResourcesPath mirrors --resource-dir.
+	StaticPodResourcesPath string `json:"staticPodResourcesPath,omitempty"`
+
+	// Revision mirrors --revision.
+	Revision int `json:"revision,omitempty"`
+
+	// ProbeInterval mirrors --probe-interval, e.g. "1s".
+	ProbeInterval metav1.Duration `json:"probeInterval,omitempty"`
+
+	// FallbackTimeout mirrors --fallback-timeout-duration, e.g. "2m".
+	FallbackTimeout metav1.Duration `json:"fallbackTimeoutDuration,omitempty"`
+
+	// HealthCheckName mirrors --health-check.
+	HealthCheckName string `json:"healthCheck,omitempty"`
+}
+
+// LoadStartupMonitorFileConfig reads and parses a StartupMonitorFileConfig from a YAML or JSON
+// file at path.
+func LoadStartupMonitorFileConfig(path string) (*StartupMonitorFileConfig, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config %q: %v", path, err)
+	}
+	config := &StartupMonitorFileConfig{}
+	if err := yaml.UnmarshalStrict(raw, config); err != nil {
+		return nil, fmt.Errorf("failed to parse config %q: %v", path, err)
+	}
+	return config, nil
+}
+
+// applyFileConfig fills in any option still at its flag default with the corresponding value
+// from file. A flag explicitly passed on the command line, per fs.Changed, is left untouched
+// even if file also sets it.
+func (o *StartupMonitorOptions) applyFileConfig(file *StartupMonitorFileConfig, fs *pflag.FlagSet) {
+	if !fs.Changed("target-name") && file.TargetName != "" {
+		o.TargetName = file.TargetName
+	}
+	if !fs.Changed("manifests-dir") && file.ManifestsPath != "" {
+		o.ManifestsPath = file.ManifestsPath
+	}
+	if !fs.Changed("resource-dir") && file.StaticPodResourcesPath != "" {
+		o.StaticPodResourcesPath = file.StaticPodResourcesPath
+	}
+	if !fs.Changed("revision") && file.Revision != 0 {
+		o.Revision = file.Revision
+	}
+	if !fs.Changed("probe-interval") && file.ProbeInterval.Duration != 0 {
+		o.ProbeInterval = file.ProbeInterval.Duration
+	}
+	if !fs.Changed("fallback-timeout-duration") && file.FallbackTimeout.Duration != 0 {
+		o.FallbackTimeout = file.FallbackTimeout.Duration
+	}
+	if !fs.Changed("health-check") && file.HealthCheckName != "" {
+		o.HealthCheckName = file.HealthCheckName
+	}
 }
@@ -0,0 +1,475 @@
+package monitor
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+)
+
+// TargetNameEnvVar and RevisionEnvVar are environment variables, typically populated by the
+// operator via the downward API in the monitor's own pod spec, holding the identity of the
+// operand the monitor guards. Consuming them through WithIdentityFromEnv lets the operator
+// avoid templating the target name and revision into the container command.
+const (
+	TargetNameEnvVar = "STARTUP_MONITOR_TARGET_NAME"
+	RevisionEnvVar   = "STARTUP_MONITOR_REVISION"
+)
+
+// Config describes everything required to construct a StartupMonitor.
+// It replaces constructing a monitor through a chain of WithXXX builder calls,
+// so that a misconfiguration (for example a probe interval that is greater
+// than the fallback timeout) is caught once, at construction time, with a
+// clear error instead of surfacing later as unexpected runtime behavior.
+type Config struct {
+	// TargetName hold the name of the operand
+	// used to construct the final file name when reading the current and previous manifests
+	TargetName string
+
+	// TargetNamespace is the namespace the operand's static pod (and its mirror pod on the
+	// API server) runs in. It is only required when Client is set.
+	TargetNamespace string
+
+	// ManifestsPath points to the directory that holds the root manifests
+	ManifestsPath string
+
+	// StaticPodResourcesPath points to the directory that holds revisioned manifests
+	StaticPodResourcesPath string
+
+	// Revision at which the monitor was started
+	Revision int
+
+	// ProbeInterval specifies a time interval at which health of the target will be assessed.
+	// Be mindful of not setting it too low, on each iteration, an i/o is involved
+	ProbeInterval time.Duration
+
+	// ProbeTimeout specifies a timeout after which the monitor starts the fall back procedure
+	ProbeTimeout time.Duration
+
+	// IsTargetHealthy abstracts away assessing operand's health condition. Check should be
+	// async and cheap in a sense that it shouldn't assess the target, only read the current
+	// state.
+	IsTargetHealthy HealthChecker
+
+	// Client is an optional Kubernetes client. When set, it unlocks API-backed features
+	// (events, status updates, node checks). The monitor remains fully functional without
+	// one, falling back to reading and writing local static pod manifests only.
+	Client kubernetes.Interface
+
+	// DynamicClient is an optional dynamic client used to patch OperatorResource with a
+	// fallback condition. Both it and OperatorResource must be set to report the condition.
+	DynamicClient dynamic.Interface
+
+	// OperatorResource identifies the singleton operator.openshift.io resource (e.g.
+	// kubeapiservers.operator.openshift.io) to patch with a fallback condition, and to read
+	// the Progressing condition from before falling back.
+	OperatorResource schema.GroupVersionResource
+
+	// ReportNodeState enables patching this node's object with a JSON-encoded NodeState
+	// annotation as the monitor's assessment of the target changes. See
+	// StartupMonitor.WithNodeStateReporting. Requires Client to also be set.
+	ReportNodeState bool
+
+	// StateFileDir, if set, enables writing a machine-readable JSON state file describing the
+	// monitor's current state for its target on every phase transition. See
+	// StartupMonitor.WithStateFileDir.
+	StateFileDir string
+
+	// AnnotationPrefix overrides the annotation domain prefix applied to the fallback pod's
+	// manifest. See StartupMonitor.WithAnnotationPrefix.
+	AnnotationPrefix string
+
+	// TerminationMessagePath, if set, enables writing a concise summary of a fatal watchdog
+	// exit to this path. See StartupMonitor.WithTerminationMessagePath.
+	TerminationMessagePath string
+
+	// MaxRolloutExtensions bounds how many times a timed-out deadline may be extended while
+	// the cluster-wide rollout is still Progressing, so a rollout that never completes can't
+	// defer a fallback forever.
+	MaxRolloutExtensions int
+
+	// SingleNodeTimeout overrides ProbeTimeout when the cluster's control plane topology is
+	// detected as SingleReplica (SNO). Only takes effect when DynamicClient is set.
+	SingleNodeTimeout time.Duration
+
+	// ServingCertFile and ServingKeyFile point to the target's serving certificate/key pair
+	// on disk, validated as an additional health signal alongside IsTargetHealthy. Both must
+	// be set to enable the check; left empty, it is skipped.
+	ServingCertFile string
+	ServingKeyFile  string
+
+	// CRISocket points to the container runtime's CRI socket, used to fetch exit codes and
+	// termination reasons for the target's containers at fallback time. Left empty, this
+	// diagnostic is skipped.
+	CRISocket string
+
+	// BootstrapAPIServerEndpoint is the address of the temporary bootstrap apiserver used
+	// during cluster installation, checked alongside the other masters' apiservers when
+	// deciding whether to fall back. Left empty, only the other masters are consulted.
+	BootstrapAPIServerEndpoint string
+
+	// SupersededGracePeriod bounds how long the monitor idles after observing that its
+	// revision has been superseded by a newer one before removing its own manifest and
+	// letting the kubelet stop it, instead of idling forever as a zombie static pod.
+	SupersededGracePeriod time.Duration
+
+	// SleepMode, once the target is observed healthy, makes the monitor idle in a low-cost
+	// sleep state instead of removing its own manifest. See WithSleepMode.
+	SleepMode bool
+
+	// LastKnownGoodHistorySize sets how many proven-good revisions the monitor keeps a
+	// pointer for, beyond the current one. See WithLastKnownGoodHistorySize.
+	LastKnownGoodHistorySize int
+
+	// KnownGoodSnapshotRetention enables the known-good snapshot manager and sets how many
+	// revisions it archives. See StartupMonitor.WithKnownGoodSnapshotRetention.
+	KnownGoodSnapshotRetention int
+
+	// VerifyDependentFiles enables checking, at fallback time, that every dependent file the
+	// revision being restored declares actually exists on disk, repairing it from the
+	// known-good snapshot archive when possible. See StartupMonitor.WithDependentFileVerification.
+	VerifyDependentFiles bool
+
+	// SuccessThreshold sets how many consecutive healthy probes are required before the
+	// target is declared healthy. See StartupMonitor.WithSuccessThreshold.
+	SuccessThreshold int
+
+	// FailureThreshold sets how many consecutive unhealthy probes are required before a
+	// timed-out deadline actually triggers a fallback. See StartupMonitor.WithFailureThreshold.
+	FailureThreshold int
+
+	// ObservationModeRevisions enables observation-only mode for the first N revisions the
+	// monitor guards. See StartupMonitor.WithObservationMode.
+	ObservationModeRevisions int
+
+	// PinnedFallbackRevision, if set, overrides the last-known-good/N-1 heuristic and forces
+	// fallback to use exactly this revision instead. See StartupMonitor.WithPinnedFallbackRevision.
+	PinnedFallbackRevision int
+
+	// InstallerLockFilePath, if set, enables coordinating with a concurrent installer pod. See
+	// StartupMonitor.WithInstallerLockFilePath.
+	InstallerLockFilePath string
+
+	// InstallerLockTimeout overrides how long sync waits to acquire InstallerLockFilePath
+	// before giving up on that tick. Only meaningful when InstallerLockFilePath is also set.
+	// Defaults to defaultInstallerLockTimeout.
+	InstallerLockTimeout time.Duration
+}
+
+// ConfigOption customizes a Config returned by NewConfig.
+type ConfigOption func(*Config)
+
+// WithTargetName sets the name of the operand.
+func WithTargetName(name string) ConfigOption {
+	return func(c *Config) { c.TargetName = name }
+}
+
+// WithTargetNamespace sets the namespace the operand's static pod runs in.
+func WithTargetNamespace(namespace string) ConfigOption {
+	return func(c *Config) { c.TargetNamespace = namespace }
+}
+
+// WithManifestsPath overrides the directory that holds the root manifests.
+func WithManifestsPath(path string) ConfigOption {
+	return func(c *Config) { c.ManifestsPath = path }
+}
+
+// WithStaticPodResourcesPath overrides the directory that holds revisioned manifests.
+func WithStaticPodResourcesPath(path string) ConfigOption {
+	return func(c *Config) { c.StaticPodResourcesPath = path }
+}
+
+// WithRevision sets the revision the monitor is guarding.
+func WithRevision(revision int) ConfigOption {
+	return func(c *Config) { c.Revision = revision }
+}
+
+// WithIdentityFromEnv sets TargetName and Revision from TargetNameEnvVar and RevisionEnvVar,
+// if set. It lets the operator populate the monitor's own pod spec through the downward API
+// instead of templating the operand's identity into the container command; apply it before
+// any explicit WithTargetName/WithRevision opt to let the latter take precedence.
+func WithIdentityFromEnv() ConfigOption {
+	return func(c *Config) {
+		if name := os.Getenv(TargetNameEnvVar); len(name) > 0 {
+			c.TargetName = name
+		}
+		if revision := os.Getenv(RevisionEnvVar); len(revision) > 0 {
+			if parsed, err := strconv.Atoi(revision); err == nil {
+				c.Revision = parsed
+			}
+		}
+	}
+}
+
+// WithRevisionFromSelfManifest sets Revision by reading it back off the monitor's own static
+// pod manifest, <target>-startup-monitor.yaml, in manifestsPath. It requires TargetName to
+// already be set, so apply it after WithTargetName/WithIdentityFromEnv. Errors reading or
+// parsing the manifest are ignored, leaving Revision at whatever it was set to before, since
+// this is meant to be one authoritative source among possibly several, not a hard requirement.
+func WithRevisionFromSelfManifest(manifestsPath string) ConfigOption {
+	return func(c *Config) {
+		selfManifest, err := LoadSelfManifest(SelfManifestInfo{TargetName: c.TargetName, ManifestsPath: manifestsPath})
+		if err != nil {
+			return
+		}
+		c.Revision = selfManifest.Revision
+	}
+}
+
+// WithManifestsPathFromKubeletConfig overrides ManifestsPath by reading the kubelet's own
+// config file at kubeletConfigFile and using its staticPodPath, so a relocated manifest
+// directory doesn't need to be duplicated as a startup-monitor flag. Errors reading or
+// parsing the kubelet config are ignored, leaving ManifestsPath at whatever it was set to
+// before (typically the "/etc/kubernetes/manifests" default), since this is meant to be a
+// best-effort override rather than a hard requirement.
+func WithManifestsPathFromKubeletConfig(kubeletConfigFile string) ConfigOption {
+	return func(c *Config) {
+		staticPodPath, err := staticPodPathFromKubeletConfig(nil, kubeletConfigFile)
+		if err != nil {
+			return
+		}
+		c.ManifestsPath = staticPodPath
+	}
+}
+
+// WithConfigProbeInterval overrides how often the target's health is assessed.
+func WithConfigProbeInterval(interval time.Duration) ConfigOption {
+	return func(c *Config) { c.ProbeInterval = interval }
+}
+
+// WithConfigProbeTimeout overrides the timeout after which the monitor starts the fall back procedure.
+func WithConfigProbeTimeout(timeout time.Duration) ConfigOption {
+	return func(c *Config) { c.ProbeTimeout = timeout }
+}
+
+// WithConfigClient sets the optional Kubernetes client used to unlock API-backed features.
+func WithConfigClient(client kubernetes.Interface) ConfigOption {
+	return func(c *Config) { c.Client = client }
+}
+
+// WithConfigDynamicClient sets the optional dynamic client used to patch the operator
+// resource with a fallback condition.
+func WithConfigDynamicClient(client dynamic.Interface) ConfigOption {
+	return func(c *Config) { c.DynamicClient = client }
+}
+
+// WithConfigOperatorResource sets the operator.openshift.io resource to patch with a
+// fallback condition.
+func WithConfigOperatorResource(resource schema.GroupVersionResource) ConfigOption {
+	return func(c *Config) { c.OperatorResource = resource }
+}
+
+// WithConfigNodeStateReporting enables patching this node's object with a JSON-encoded
+// NodeState annotation as the monitor's assessment of the target changes. See
+// StartupMonitor.WithNodeStateReporting.
+func WithConfigNodeStateReporting() ConfigOption {
+	return func(c *Config) { c.ReportNodeState = true }
+}
+
+// WithConfigStateFileDir enables writing a machine-readable JSON state file describing the
+// monitor's current state for its target into dir on every phase transition. See
+// StartupMonitor.WithStateFileDir.
+func WithConfigStateFileDir(dir string) ConfigOption {
+	return func(c *Config) { c.StateFileDir = dir }
+}
+
+// WithConfigAnnotationPrefix overrides the annotation domain prefix applied to the fallback
+// pod's manifest. See StartupMonitor.WithAnnotationPrefix.
+func WithConfigAnnotationPrefix(prefix string) ConfigOption {
+	return func(c *Config) { c.AnnotationPrefix = prefix }
+}
+
+// WithConfigTerminationMessagePath enables writing a concise summary of a fatal watchdog exit
+// to path. See StartupMonitor.WithTerminationMessagePath.
+func WithConfigTerminationMessagePath(path string) ConfigOption {
+	return func(c *Config) { c.TerminationMessagePath = path }
+}
+
+// WithConfigServingCertificate enables validating the target's serving certificate/key pair
+// on disk as an additional health signal alongside IsTargetHealthy.
+func WithConfigServingCertificate(certFile, keyFile string) ConfigOption {
+	return func(c *Config) {
+		c.ServingCertFile = certFile
+		c.ServingKeyFile = keyFile
+	}
+}
+
+// WithConfigCRISocket enables fetching exit codes and termination reasons for the target's
+// containers via the CRI at fallback time.
+func WithConfigCRISocket(socket string) ConfigOption {
+	return func(c *Config) { c.CRISocket = socket }
+}
+
+// WithConfigBootstrapAPIServerEndpoint sets the host:port of the temporary bootstrap
+// apiserver used during cluster installation, checked as an alternative health reference
+// alongside the other masters' apiservers.
+func WithConfigBootstrapAPIServerEndpoint(endpoint string) ConfigOption {
+	return func(c *Config) { c.BootstrapAPIServerEndpoint = endpoint }
+}
+
+// WithConfigSupersededGracePeriod overrides how long the monitor idles after observing that
+// its revision has been superseded before removing its own manifest and exiting.
+func WithConfigSupersededGracePeriod(gracePeriod time.Duration) ConfigOption {
+	return func(c *Config) { c.SupersededGracePeriod = gracePeriod }
+}
+
+// WithConfigMaxRolloutExtensions overrides how many times a timed-out deadline may be
+// extended while the cluster-wide rollout is still Progressing.
+func WithConfigMaxRolloutExtensions(max int) ConfigOption {
+	return func(c *Config) { c.MaxRolloutExtensions = max }
+}
+
+// WithConfigSingleNodeTimeout overrides the timeout applied when a SingleReplica control
+// plane topology is detected.
+func WithConfigSingleNodeTimeout(timeout time.Duration) ConfigOption {
+	return func(c *Config) { c.SingleNodeTimeout = timeout }
+}
+
+// WithConfigSleepMode configures the monitor, once the target is observed healthy, to idle in
+// a low-cost sleep state instead of removing its own manifest. See StartupMonitor.WithSleepMode.
+func WithConfigSleepMode() ConfigOption {
+	return func(c *Config) { c.SleepMode = true }
+}
+
+// WithConfigLastKnownGoodHistorySize sets how many proven-good revisions the monitor keeps a
+// pointer for, beyond the current one. See StartupMonitor.WithLastKnownGoodHistorySize.
+func WithConfigLastKnownGoodHistorySize(size int) ConfigOption {
+	return func(c *Config) { c.LastKnownGoodHistorySize = size }
+}
+
+// WithConfigKnownGoodSnapshotRetention enables the known-good snapshot manager and sets how
+// many revisions it archives. See StartupMonitor.WithKnownGoodSnapshotRetention.
+func WithConfigKnownGoodSnapshotRetention(retention int) ConfigOption {
+	return func(c *Config) { c.KnownGoodSnapshotRetention = retention }
+}
+
+// WithConfigDependentFileVerification enables checking, at fallback time, that every dependent
+// file the revision being restored declares actually exists on disk, repairing it from the
+// known-good snapshot archive when possible. See StartupMonitor.WithDependentFileVerification.
+func WithConfigDependentFileVerification() ConfigOption {
+	return func(c *Config) { c.VerifyDependentFiles = true }
+}
+
+// WithConfigSuccessThreshold sets how many consecutive healthy probes are required before the
+// target is declared healthy. See StartupMonitor.WithSuccessThreshold.
+func WithConfigSuccessThreshold(n int) ConfigOption {
+	return func(c *Config) { c.SuccessThreshold = n }
+}
+
+// WithConfigFailureThreshold sets how many consecutive unhealthy probes are required before a
+// timed-out deadline actually triggers a fallback. See StartupMonitor.WithFailureThreshold.
+func WithConfigFailureThreshold(n int) ConfigOption {
+	return func(c *Config) { c.FailureThreshold = n }
+}
+
+// WithConfigObservationMode enables observation-only mode for the first N revisions the
+// monitor guards. See StartupMonitor.WithObservationMode.
+func WithConfigObservationMode(revisions int) ConfigOption {
+	return func(c *Config) { c.ObservationModeRevisions = revisions }
+}
+
+// WithConfigPinnedFallbackRevision overrides the last-known-good/N-1 heuristic and forces
+// fallback to use exactly revision instead. See StartupMonitor.WithPinnedFallbackRevision.
+func WithConfigPinnedFallbackRevision(revision int) ConfigOption {
+	return func(c *Config) { c.PinnedFallbackRevision = revision }
+}
+
+// WithConfigInstallerLockFilePath enables coordinating with a concurrent installer pod. See
+// StartupMonitor.WithInstallerLockFilePath.
+func WithConfigInstallerLockFilePath(path string) ConfigOption {
+	return func(c *Config) { c.InstallerLockFilePath = path }
+}
+
+// WithConfigInstallerLockTimeout overrides how long sync waits to acquire
+// InstallerLockFilePath before giving up on that tick. See StartupMonitor.WithInstallerLockTimeout.
+func WithConfigInstallerLockTimeout(timeout time.Duration) ConfigOption {
+	return func(c *Config) { c.InstallerLockTimeout = timeout }
+}
+
+// NewConfig returns a defaulted Config for the given health check function, applies opts on
+// top of the defaults and validates the result.
+func NewConfig(isTargetHealthy HealthChecker, opts ...ConfigOption) (*Config, error) {
+	c := &Config{
+		ManifestsPath:            "/etc/kubernetes/manifests",
+		StaticPodResourcesPath:   "/etc/kubernetes/static-pod-resources",
+		ProbeInterval:            time.Second,
+		ProbeTimeout:             120 * time.Second,
+		IsTargetHealthy:          isTargetHealthy,
+		MaxRolloutExtensions:     defaultMaxRolloutExtensions,
+		SingleNodeTimeout:        defaultSingleNodeTimeout,
+		SupersededGracePeriod:    defaultSupersededGracePeriod,
+		LastKnownGoodHistorySize: defaultLastKnownGoodHistorySize,
+		InstallerLockTimeout:     defaultInstallerLockTimeout,
+		SuccessThreshold:         defaultSuccessThreshold,
+		FailureThreshold:         defaultFailureThreshold,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if err := c.validate(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *Config) validate() error {
+	if c.IsTargetHealthy == nil {
+		return fmt.Errorf("a health checker must be provided")
+	}
+	if len(c.TargetName) == 0 {
+		return fmt.Errorf("target name cannot be empty")
+	}
+	if len(c.ManifestsPath) == 0 {
+		return fmt.Errorf("manifests path cannot be empty")
+	}
+	if len(c.StaticPodResourcesPath) == 0 {
+		return fmt.Errorf("static pod resources path cannot be empty")
+	}
+	if c.Revision < 0 {
+		return fmt.Errorf("revision cannot be negative, got %d", c.Revision)
+	}
+	if c.ProbeInterval <= 0 {
+		return fmt.Errorf("probe interval must be greater than 0")
+	}
+	if c.ProbeTimeout <= 0 {
+		return fmt.Errorf("probe timeout must be greater than 0")
+	}
+	if c.ProbeInterval >= c.ProbeTimeout {
+		return fmt.Errorf("probe interval (%s) must be less than probe timeout (%s)", c.ProbeInterval, c.ProbeTimeout)
+	}
+	if c.Client != nil && len(c.TargetNamespace) == 0 {
+		return fmt.Errorf("target namespace cannot be empty when a client is set")
+	}
+	if c.MaxRolloutExtensions < 0 {
+		return fmt.Errorf("max rollout extensions cannot be negative, got %d", c.MaxRolloutExtensions)
+	}
+	if c.LastKnownGoodHistorySize < 1 {
+		return fmt.Errorf("last known good history size must be at least 1, got %d", c.LastKnownGoodHistorySize)
+	}
+	if c.SupersededGracePeriod < 0 {
+		return fmt.Errorf("superseded grace period cannot be negative, got %s", c.SupersededGracePeriod)
+	}
+	if c.KnownGoodSnapshotRetention < 0 {
+		return fmt.Errorf("known good snapshot retention cannot be negative, got %d", c.KnownGoodSnapshotRetention)
+	}
+	if c.ObservationModeRevisions < 0 {
+		return fmt.Errorf("observation mode revisions cannot be negative, got %d", c.ObservationModeRevisions)
+	}
+	if c.PinnedFallbackRevision < 0 {
+		return fmt.Errorf("pinned fallback revision cannot be negative, got %d", c.PinnedFallbackRevision)
+	}
+	if c.SuccessThreshold < 1 {
+		return fmt.Errorf("success threshold must be at least 1, got %d", c.SuccessThreshold)
+	}
+	if c.FailureThreshold < 1 {
+		return fmt.Errorf("failure threshold must be at least 1, got %d", c.FailureThreshold)
+	}
+	if len(c.InstallerLockFilePath) > 0 && c.InstallerLockTimeout <= 0 {
+		return fmt.Errorf("installer lock timeout must be greater than 0 when an installer lock file path is set")
+	}
+	return nil
+}
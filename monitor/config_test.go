@@ -0,0 +1,63 @@
+package monitor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/p0lyn0mial/startup-monitor/monitor/monitortesting"
+)
+
+func TestNewConfig(t *testing.T) {
+	alwaysHealthy := HealthCheckerFunc(func(ctx context.Context) (bool, string, error) { return true, "", nil })
+
+	scenarios := []struct {
+		name        string
+		opts        []ConfigOption
+		healthCheck HealthChecker
+		expectedErr string
+	}{
+		// scenario 1
+		{
+			name:        "no health checker",
+			expectedErr: "a health checker must be provided",
+		},
+
+		// scenario 2
+		{
+			name:        "no target name",
+			healthCheck: alwaysHealthy,
+			expectedErr: "target name cannot be empty",
+		},
+
+		// scenario 3
+		{
+			name:        "negative revision",
+			healthCheck: alwaysHealthy,
+			opts:        []ConfigOption{WithTargetName("kube-apiserver"), WithRevision(-1)},
+			expectedErr: "revision cannot be negative, got -1",
+		},
+
+		// scenario 4
+		{
+			name:        "probe interval greater than probe timeout",
+			healthCheck: alwaysHealthy,
+			opts:        []ConfigOption{WithTargetName("kube-apiserver"), WithConfigProbeInterval(5 * time.Second), WithConfigProbeTimeout(time.Second)},
+			expectedErr: "probe interval (5s) must be less than probe timeout (1s)",
+		},
+
+		// scenario 5
+		{
+			name:        "happy path with defaults",
+			healthCheck: alwaysHealthy,
+			opts:        []ConfigOption{WithTargetName("kube-apiserver")},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			_, err := NewConfig(scenario.healthCheck, scenario.opts...)
+			monitortesting.AssertError(t, err, scenario.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package monitor
+
+import (
+	"testing"
+
+	"github.com/p0lyn0mial/startup-monitor/monitor/monitortesting"
+)
+
+// TestRealFSConformance runs the shared IOBackend conformance suite against realFS, the
+// production IOInterface implementation.
+func TestRealFSConformance(t *testing.T) {
+	monitortesting.RunIOConformanceSuite(t, func(dir string) monitortesting.IOBackend {
+		return realFS{}
+	})
+}
+
+// TestFaultInjectingIOConformanceWithNoFaults runs the shared IOBackend conformance suite
+// against a FaultInjectingIO wrapping realFS with every fault rate at zero, so it must behave
+// identically to its backend: this catches a decorator bug (e.g. an accidental delegation
+// mistake) independently of any test that only exercises FaultInjectingIO with faults enabled.
+func TestFaultInjectingIOConformanceWithNoFaults(t *testing.T) {
+	monitortesting.RunIOConformanceSuite(t, func(dir string) monitortesting.IOBackend {
+		return monitortesting.NewFaultInjectingIO(realFS{}, monitortesting.FaultConfig{})
+	})
+}
@@ -0,0 +1,103 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+
+	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1alpha2"
+)
+
+// criDialTimeout bounds how long connecting to the CRI socket may take.
+const criDialTimeout = 5 * time.Second
+
+// ContainerExitInfo summarizes why one of the target's containers exited, as reported by the
+// CRI. Exit code 137 (SIGKILL, often an OOM kill) and a config-parse crash both surface as
+// "unhealthy" to a plain liveness probe, but call for very different operator advice.
+type ContainerExitInfo struct {
+	// Name is the container's name within the pod.
+	Name string
+
+	// ExitCode is the exit code the container's process returned.
+	ExitCode int32
+
+	// Reason is the CRI's brief CamelCase explanation of the exit, e.g. "OOMKilled" or "Error".
+	Reason string
+
+	// Message has additional human-readable detail about the exit, if any.
+	Message string
+}
+
+// containerExitDiagnostics fetches exit codes and termination reasons for the target's
+// containers via the CRI, matching containers whose pod name and namespace labels identify
+// them as belonging to the target's mirror pod. It requires sm.criSocket to be set; when it
+// is unset it reports no diagnostics so the health assessment is unaffected by default.
+func (sm *StartupMonitor) containerExitDiagnostics(ctx context.Context) ([]ContainerExitInfo, error) {
+	if len(sm.criSocket) == 0 {
+		return nil, nil
+	}
+
+	nodeName, err := nodeName()
+	if err != nil {
+		return nil, fmt.Errorf("failed to determine the node name: %v", err)
+	}
+	mirrorPodName := fmt.Sprintf("%s-%s", sm.targetName, nodeName)
+
+	dialCtx, cancel := context.WithTimeout(ctx, criDialTimeout)
+	defer cancel()
+	conn, err := grpc.DialContext(dialCtx, "unix://"+sm.criSocket, grpc.WithInsecure(), grpc.WithBlock())
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the CRI socket %q: %v", sm.criSocket, err)
+	}
+	defer conn.Close()
+
+	client := runtimeapi.NewRuntimeServiceClient(conn)
+	listResp, err := client.ListContainers(ctx, &runtimeapi.ListContainersRequest{
+		Filter: &runtimeapi.ContainerFilter{
+			LabelSelector: map[string]string{
+				"io.kubernetes.pod.name":      mirrorPodName,
+				"io.kubernetes.pod.namespace": sm.targetNamespace,
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list containers for %s via the CRI: %v", mirrorPodName, err)
+	}
+
+	var diagnostics []ContainerExitInfo
+	for _, container := range listResp.Containers {
+		statusResp, err := client.ContainerStatus(ctx, &runtimeapi.ContainerStatusRequest{ContainerId: container.Id})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the status of container %s via the CRI: %v", container.Id, err)
+		}
+		status := statusResp.Status
+		if status == nil || status.FinishedAt == 0 {
+			continue
+		}
+		diagnostics = append(diagnostics, ContainerExitInfo{
+			Name:     status.Metadata.GetName(),
+			ExitCode: status.ExitCode,
+			Reason:   status.Reason,
+			Message:  status.Message,
+		})
+	}
+
+	return diagnostics, nil
+}
+
+// summarizeContainerExitDiagnostics renders diagnostics as a short, human-readable summary
+// suitable for inclusion in fallback messages, e.g. "kube-apiserver exited 137 (OOMKilled)".
+func summarizeContainerExitDiagnostics(diagnostics []ContainerExitInfo) string {
+	var summaries []string
+	for _, diagnostic := range diagnostics {
+		summary := fmt.Sprintf("%s exited %d", diagnostic.Name, diagnostic.ExitCode)
+		if len(diagnostic.Reason) > 0 {
+			summary += fmt.Sprintf(" (%s)", diagnostic.Reason)
+		}
+		summaries = append(summaries, summary)
+	}
+	return strings.Join(summaries, ", ")
+}
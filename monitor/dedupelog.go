@@ -0,0 +1,57 @@
+package monitor
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+// dedupWindow is how long an identical log line is suppressed for after it was last logged, so
+// a tight sync loop (probeInterval as low as 1s during a fallback countdown) doesn't spam the
+// node journal with the same line on every tick.
+const dedupWindow = 30 * time.Second
+
+// dedupLogger collapses a line logged repeatedly, identically, within dedupWindow of the
+// previous occurrence into a single line carrying a repeat count, emitted once the line
+// changes or dedupWindow elapses.
+type dedupLogger struct {
+	lock       sync.Mutex
+	lastLine   string
+	lastLogged time.Time
+	repeats    int
+}
+
+// Infof behaves like klog.Infof, except a line identical to the previous one logged within
+// dedupWindow is counted instead of emitted again.
+func (d *dedupLogger) Infof(format string, args ...interface{}) {
+	d.log(klog.Info, format, args...)
+}
+
+// Warningf behaves like klog.Warningf, except a line identical to the previous one logged
+// within dedupWindow is counted instead of emitted again.
+func (d *dedupLogger) Warningf(format string, args ...interface{}) {
+	d.log(klog.Warning, format, args...)
+}
+
+func (d *dedupLogger) log(emit func(...interface{}), format string, args ...interface{}) {
+	line := fmt.Sprintf(format, args...)
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	now := time.Now()
+	if line == d.lastLine && now.Sub(d.lastLogged) < dedupWindow {
+		d.repeats++
+		d.lastLogged = now
+		return
+	}
+	if d.repeats > 0 {
+		emit(fmt.Sprintf("%s (repeated %d additional times)", d.lastLine, d.repeats))
+	}
+	emit(line)
+	d.lastLine = line
+	d.lastLogged = now
+	d.repeats = 0
+}
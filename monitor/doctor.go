@@ -0,0 +1,405 @@
+package monitor
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/library-go/pkg/operator/resource/resourceread"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// DoctorSeverity ranks a Finding by how urgently it needs attention.
+type DoctorSeverity int
+
+const (
+	// DoctorInfo is an observation that doesn't indicate a problem on its own.
+	DoctorInfo DoctorSeverity = iota
+	// DoctorWarning is a problem that degrades the monitor's guarantees but isn't necessarily
+	// causing active harm yet.
+	DoctorWarning
+	// DoctorCritical is a problem likely to already be causing, or about to cause, a failed
+	// fallback or an unmonitored operand.
+	DoctorCritical
+)
+
+func (s DoctorSeverity) String() string {
+	switch s {
+	case DoctorCritical:
+		return "CRITICAL"
+	case DoctorWarning:
+		return "WARNING"
+	default:
+		return "INFO"
+	}
+}
+
+// Finding is a single diagnosis produced by RunDoctor.
+type Finding struct {
+	// Severity ranks how urgently Message needs attention.
+	Severity DoctorSeverity
+	// Check names the diagnosis that produced this Finding, e.g. "dangling last-known-good pointer".
+	Check string
+	// Message describes what was observed.
+	Message string
+	// Suggestion, if non-empty, recommends a fix.
+	Suggestion string
+}
+
+// DoctorOptions configures RunDoctor.
+type DoctorOptions struct {
+	// TargetName is the operand name, e.g. "kube-apiserver".
+	TargetName string
+
+	// ManifestsPath is the directory holding the root manifest.
+	ManifestsPath string
+
+	// StaticPodResourcesPath is the directory holding revisioned manifests.
+	StaticPodResourcesPath string
+
+	// Layout is the directory scheme in use. Defaults to openshiftLayout.
+	Layout Layout
+
+	// ProbeEndpoint, if set, is an HTTP(S) URL RunDoctor checks for reachability, e.g.
+	// "https://localhost:6443/healthz". Left empty, the check is skipped.
+	ProbeEndpoint string
+}
+
+// RunDoctor inspects the on-disk manifest layout and configuration described by opts for
+// misconfigurations that would otherwise only surface as a confusing failure the next time the
+// monitor actually needs to act, and returns the resulting Findings, most severe first.
+func RunDoctor(ctx context.Context, opts DoctorOptions) []Finding {
+	if opts.Layout == nil {
+		opts.Layout = openshiftLayout{}
+	}
+
+	var findings []Finding
+	findings = append(findings, checkDoctorDirectory("manifests directory", opts.ManifestsPath)...)
+	findings = append(findings, checkDoctorDirectory("static pod resources directory", opts.StaticPodResourcesPath)...)
+	findings = append(findings, checkDoctorDanglingLastKnownGood(opts)...)
+	findings = append(findings, checkDoctorRevisionMismatches(opts)...)
+	findings = append(findings, checkDoctorClockSanity()...)
+	if opts.ProbeEndpoint != "" {
+		findings = append(findings, checkDoctorProbeEndpoint(ctx, opts)...)
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool { return findings[i].Severity > findings[j].Severity })
+	return findings
+}
+
+// checkDoctorDirectory reports a missing, non-directory, or unwritable dir, labeled label in
+// any resulting Finding.
+func checkDoctorDirectory(label, dir string) []Finding {
+	if dir == "" {
+		return []Finding{{
+			Severity:   DoctorCritical,
+			Check:      label,
+			Message:    fmt.Sprintf("%s is not configured", label),
+			Suggestion: "set the corresponding path before running the monitor",
+		}}
+	}
+
+	info, err := os.Stat(dir)
+	if os.IsNotExist(err) {
+		return []Finding{{
+			Severity:   DoctorCritical,
+			Check:      label,
+			Message:    fmt.Sprintf("%s %q does not exist", label, dir),
+			Suggestion: fmt.Sprintf("create %q with the permissions the kubelet and installer expect", dir),
+		}}
+	}
+	if err != nil {
+		return []Finding{{
+			Severity:   DoctorCritical,
+			Check:      label,
+			Message:    fmt.Sprintf("failed to stat %s %q: %v", label, dir, err),
+			Suggestion: "investigate the underlying filesystem error",
+		}}
+	}
+	if !info.IsDir() {
+		return []Finding{{
+			Severity:   DoctorCritical,
+			Check:      label,
+			Message:    fmt.Sprintf("%s %q is not a directory", label, dir),
+			Suggestion: fmt.Sprintf("remove %q and recreate it as a directory", dir),
+		}}
+	}
+
+	probeFile := path.Join(dir, ".startup-monitor-doctor-probe")
+	if err := os.WriteFile(probeFile, []byte{}, 0600); err != nil {
+		return []Finding{{
+			Severity:   DoctorCritical,
+			Check:      label,
+			Message:    fmt.Sprintf("%s %q does not appear to be writable: %v", label, dir, err),
+			Suggestion: "fix the directory's ownership or permissions so the monitor can write manifests",
+		}}
+	}
+	_ = os.Remove(probeFile)
+	return nil
+}
+
+// checkDoctorDanglingLastKnownGood reports a last known good pointer that exists on disk but
+// whose target is missing, e.g. because the revision it pointed at was pruned or its resource
+// directory was removed out from under it: fileExists follows the symlink and would treat it as
+// present, only to fail when fallbackToPreviousRevision actually tries to read it.
+func checkDoctorDanglingLastKnownGood(opts DoctorOptions) []Finding {
+	pointerPath := opts.Layout.LastKnownGoodManifestDstPath(opts.StaticPodResourcesPath, opts.TargetName)
+
+	linkInfo, err := os.Lstat(pointerPath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return []Finding{{
+			Severity:   DoctorWarning,
+			Check:      "dangling last-known-good pointer",
+			Message:    fmt.Sprintf("failed to stat the last known good pointer %q: %v", pointerPath, err),
+			Suggestion: "investigate the underlying filesystem error",
+		}}
+	}
+	if linkInfo.Mode()&os.ModeSymlink == 0 {
+		// symlinkOrCopy falls back to a plain copy on EXDEV/EPERM; a regular file here is
+		// expected and can't dangle.
+		return nil
+	}
+
+	if _, err := os.Stat(pointerPath); os.IsNotExist(err) {
+		target, readlinkErr := os.Readlink(pointerPath)
+		if readlinkErr != nil {
+			target = "<unreadable>"
+		}
+		return []Finding{{
+			Severity:   DoctorCritical,
+			Check:      "dangling last-known-good pointer",
+			Message:    fmt.Sprintf("%q points at %q, which no longer exists", pointerPath, target),
+			Suggestion: fmt.Sprintf("remove %q so the monitor falls back to scanning the resource directory for a previous revision instead of failing to read a dangling pointer", pointerPath),
+		}}
+	} else if err != nil {
+		return []Finding{{
+			Severity:   DoctorWarning,
+			Check:      "dangling last-known-good pointer",
+			Message:    fmt.Sprintf("failed to resolve the last known good pointer %q: %v", pointerPath, err),
+			Suggestion: "investigate the underlying filesystem error",
+		}}
+	}
+	return nil
+}
+
+// checkDoctorRevisionMismatches scans opts.StaticPodResourcesPath for openshift-style
+// "<target>-pod-<revision>" resource directories and reports any whose manifest's revision
+// label disagrees with the revision encoded in its directory name, the same mismatch
+// scanPreviousRevision would otherwise silently misattribute a fallback target from. Like
+// scanPreviousRevision, it only understands the openshift Layout's directory naming; other
+// layouts are skipped.
+func checkDoctorRevisionMismatches(opts DoctorOptions) []Finding {
+	if _, ok := opts.Layout.(openshiftLayout); !ok {
+		return nil
+	}
+
+	entries, err := os.ReadDir(opts.StaticPodResourcesPath)
+	if err != nil {
+		return []Finding{{
+			Severity:   DoctorCritical,
+			Check:      "revision label/directory mismatch",
+			Message:    fmt.Sprintf("failed to list %q: %v", opts.StaticPodResourcesPath, err),
+			Suggestion: "confirm the static pod resources path is correct and readable",
+		}}
+	}
+
+	var findings []Finding
+	prefix := opts.TargetName + "-pod-"
+	for _, entry := range entries {
+		if !entry.IsDir() || !strings.HasPrefix(entry.Name(), prefix) {
+			continue
+		}
+		expectedRevision, err := strconv.Atoi(strings.TrimPrefix(entry.Name(), prefix))
+		if err != nil {
+			findings = append(findings, Finding{
+				Severity:   DoctorWarning,
+				Check:      "revision label/directory mismatch",
+				Message:    fmt.Sprintf("directory %q does not encode a numeric revision", entry.Name()),
+				Suggestion: "confirm no unrelated directory was placed under the static pod resources path",
+			})
+			continue
+		}
+
+		manifestPath := opts.Layout.TargetManifestPathFor(opts.StaticPodResourcesPath, opts.TargetName, expectedRevision)
+		pod, err := readDoctorPodManifest(manifestPath)
+		if err != nil {
+			findings = append(findings, Finding{
+				Severity:   DoctorCritical,
+				Check:      "revision label/directory mismatch",
+				Message:    fmt.Sprintf("failed to read the manifest for revision %d at %q: %v", expectedRevision, manifestPath, err),
+				Suggestion: "confirm the manifest exists and is a well-formed pod manifest",
+			})
+			continue
+		}
+		if actualRevision, err := revisionOfPod(pod); err != nil {
+			findings = append(findings, Finding{
+				Severity:   DoctorCritical,
+				Check:      "revision label/directory mismatch",
+				Message:    fmt.Sprintf("manifest at %q has an invalid revision label: %v", manifestPath, err),
+				Suggestion: "correct or remove the malformed manifest before the monitor next reads it",
+			})
+		} else if actualRevision != expectedRevision {
+			findings = append(findings, Finding{
+				Severity:   DoctorCritical,
+				Check:      "revision label/directory mismatch",
+				Message:    fmt.Sprintf("directory %q encodes revision %d but its manifest's revision label is %d", entry.Name(), expectedRevision, actualRevision),
+				Suggestion: "regenerate or discard this revision directory before it can be selected as a fallback target",
+			})
+		}
+	}
+	return findings
+}
+
+// readDoctorPodManifest reads and parses the pod manifest at filepath, mirroring
+// StartupMonitor.readTargetPod for the doctor subcommand, which runs standalone, without a
+// StartupMonitor instance.
+func readDoctorPodManifest(filepath string) (*corev1.Pod, error) {
+	raw, err := os.ReadFile(filepath)
+	if err != nil {
+		return nil, err
+	}
+	return resourceread.ReadPodV1(raw)
+}
+
+// doctorClockSampleInterval is how long checkDoctorClockSanity waits between its two time
+// samples: long enough for a wall-clock/monotonic divergence introduced by an NTP step or a
+// suspend/resume to show up, short enough not to make `doctor` noticeably slow to run.
+const doctorClockSampleInterval = 250 * time.Millisecond
+
+// checkDoctorClockSanity samples the wall clock and the monotonic clock doctorClockSampleInterval
+// apart and reports if they diverge by more than clockJumpThreshold, the same threshold
+// detectClockJump applies between sync ticks, since a node exhibiting the divergence right now is
+// worth flagging up front rather than waiting for it to show up in the monitor's own logs.
+func checkDoctorClockSanity() []Finding {
+	before := time.Now()
+	time.Sleep(doctorClockSampleInterval)
+	after := time.Now()
+
+	monotonicDelta := after.Sub(before)
+	wallDelta := after.Round(0).Sub(before.Round(0))
+	drift := wallDelta - monotonicDelta
+	if drift < 0 {
+		drift = -drift
+	}
+	if drift > clockJumpThreshold {
+		return []Finding{{
+			Severity:   DoctorWarning,
+			Check:      "clock sanity",
+			Message:    fmt.Sprintf("observed a %s wall-clock/monotonic clock divergence over a %s sample window", drift, doctorClockSampleInterval),
+			Suggestion: "check for an active NTP step, a recent suspend/resume, or a misbehaving hardware clock on this node",
+		}}
+	}
+	return nil
+}
+
+// doctorProbeTimeout bounds how long checkDoctorProbeEndpoint waits for opts.ProbeEndpoint to
+// answer.
+const doctorProbeTimeout = 5 * time.Second
+
+// doctorProbeHTTPClient probes opts.ProbeEndpoint. Its serving certificate isn't necessarily
+// available to this standalone command, so verification is skipped: this call only establishes
+// reachability, not identity, matching peerHealthHTTPClient's rationale.
+var doctorProbeHTTPClient = &http.Client{
+	Timeout:   doctorProbeTimeout,
+	Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}},
+}
+
+// checkDoctorProbeEndpoint reports whether opts.ProbeEndpoint is reachable and answers with a
+// 200.
+func checkDoctorProbeEndpoint(ctx context.Context, opts DoctorOptions) []Finding {
+	ctx, cancel := context.WithTimeout(ctx, doctorProbeTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, opts.ProbeEndpoint, nil)
+	if err != nil {
+		return []Finding{{
+			Severity:   DoctorCritical,
+			Check:      "probe endpoint reachability",
+			Message:    fmt.Sprintf("%q is not a valid URL: %v", opts.ProbeEndpoint, err),
+			Suggestion: "pass a well-formed URL to --probe-endpoint, e.g. https://localhost:6443/healthz",
+		}}
+	}
+	resp, err := doctorProbeHTTPClient.Do(req)
+	if err != nil {
+		return []Finding{{
+			Severity:   DoctorCritical,
+			Check:      "probe endpoint reachability",
+			Message:    fmt.Sprintf("%s is unreachable: %v", opts.ProbeEndpoint, err),
+			Suggestion: "confirm the operand is running and listening, and that no firewall or network policy blocks the monitor from reaching it",
+		}}
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return []Finding{{
+			Severity:   DoctorWarning,
+			Check:      "probe endpoint reachability",
+			Message:    fmt.Sprintf("%s responded with HTTP %d", opts.ProbeEndpoint, resp.StatusCode),
+			Suggestion: "investigate why the operand's health endpoint isn't reporting healthy",
+		}}
+	}
+	return nil
+}
+
+// newDoctorCommand builds the "doctor" subcommand, which diagnoses common misconfigurations
+// against a real (or suspect) manifest layout, without running the monitor itself.
+func newDoctorCommand() *cobra.Command {
+	opts := DoctorOptions{}
+	var layoutName string
+
+	cmd := &cobra.Command{
+		Use:   "doctor",
+		Short: "Diagnoses common misconfigurations in a startup-monitor manifest layout.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			layout, err := layoutForName(layoutName)
+			if err != nil {
+				return err
+			}
+			opts.Layout = layout
+
+			findings := RunDoctor(cmd.Context(), opts)
+			if len(findings) == 0 {
+				fmt.Println("No issues found.")
+				return nil
+			}
+
+			worst := DoctorInfo
+			for _, finding := range findings {
+				fmt.Printf("[%s] %s: %s\n", finding.Severity, finding.Check, finding.Message)
+				if finding.Suggestion != "" {
+					fmt.Printf("  suggestion: %s\n", finding.Suggestion)
+				}
+				if finding.Severity > worst {
+					worst = finding.Severity
+				}
+			}
+			if worst == DoctorCritical {
+				return fmt.Errorf("doctor found %d finding(s), the most severe being CRITICAL", len(findings))
+			}
+			return nil
+		},
+	}
+
+	fs := cmd.Flags()
+	fs.StringVar(&opts.TargetName, "target-name", "", "name of the operand, e.g. \"kube-apiserver\" (required)")
+	fs.StringVar(&opts.ManifestsPath, "manifests-path", "", "directory holding the root manifest (required)")
+	fs.StringVar(&opts.StaticPodResourcesPath, "static-pod-resources-path", "", "directory holding revisioned manifests (required)")
+	fs.StringVar(&layoutName, "layout", "", "directory scheme used to locate manifests: \"openshift\" (default) or \"kubeadm\"")
+	fs.StringVar(&opts.ProbeEndpoint, "probe-endpoint", "", "URL to check for reachability, e.g. https://localhost:6443/healthz; left unset, the check is skipped")
+	_ = cmd.MarkFlagRequired("target-name")
+	_ = cmd.MarkFlagRequired("manifests-path")
+	_ = cmd.MarkFlagRequired("static-pod-resources-path")
+
+	return cmd
+}
@@ -0,0 +1,176 @@
+package monitor
+
+import (
+	"context"
+	"os"
+	"path"
+	"strings"
+	"testing"
+)
+
+func TestRunDoctorHealthyLayout(t *testing.T) {
+	manifestsPath := t.TempDir()
+	staticPodResourcesPath := t.TempDir()
+
+	revisionDir := path.Join(staticPodResourcesPath, "kube-apiserver-pod-9")
+	if err := os.MkdirAll(revisionDir, 0755); err != nil {
+		t.Fatalf("failed to create the revision directory: %v", err)
+	}
+	if err := os.WriteFile(path.Join(revisionDir, "kube-apiserver-pod.yaml"), []byte(simulationPodManifest("kube-apiserver", 9)), 0644); err != nil {
+		t.Fatalf("failed to write the revision manifest: %v", err)
+	}
+
+	findings := RunDoctor(context.Background(), DoctorOptions{
+		TargetName:             "kube-apiserver",
+		ManifestsPath:          manifestsPath,
+		StaticPodResourcesPath: staticPodResourcesPath,
+	})
+	if len(findings) != 0 {
+		t.Errorf("expected no findings for a healthy layout, got %+v", findings)
+	}
+}
+
+func TestRunDoctorMissingDirectories(t *testing.T) {
+	findings := RunDoctor(context.Background(), DoctorOptions{
+		TargetName:             "kube-apiserver",
+		ManifestsPath:          path.Join(t.TempDir(), "does-not-exist"),
+		StaticPodResourcesPath: path.Join(t.TempDir(), "also-missing"),
+	})
+
+	var missingDirFindings int
+	for _, finding := range findings {
+		if finding.Severity != DoctorCritical {
+			t.Errorf("expected only CRITICAL findings for missing directories, got %s: %+v", finding.Severity, finding)
+		}
+		if strings.HasSuffix(finding.Check, "directory") {
+			missingDirFindings++
+			if !strings.Contains(finding.Message, "does not exist") {
+				t.Errorf("unexpected message %q", finding.Message)
+			}
+		}
+	}
+	if missingDirFindings != 2 {
+		t.Errorf("expected 2 missing-directory findings, got %d: %+v", missingDirFindings, findings)
+	}
+}
+
+func TestRunDoctorDanglingLastKnownGood(t *testing.T) {
+	manifestsPath := t.TempDir()
+	staticPodResourcesPath := t.TempDir()
+
+	pointerPath := openshiftLayout{}.LastKnownGoodManifestDstPath(staticPodResourcesPath, "kube-apiserver")
+	if err := os.Symlink(path.Join(staticPodResourcesPath, "kube-apiserver-pod-9", "kube-apiserver-pod.yaml"), pointerPath); err != nil {
+		t.Fatalf("failed to create the dangling symlink: %v", err)
+	}
+
+	findings := RunDoctor(context.Background(), DoctorOptions{
+		TargetName:             "kube-apiserver",
+		ManifestsPath:          manifestsPath,
+		StaticPodResourcesPath: staticPodResourcesPath,
+	})
+
+	found := false
+	for _, finding := range findings {
+		if finding.Check == "dangling last-known-good pointer" {
+			found = true
+			if finding.Severity != DoctorCritical {
+				t.Errorf("expected a CRITICAL finding, got %s", finding.Severity)
+			}
+		}
+	}
+	if !found {
+		t.Errorf("expected a dangling last-known-good pointer finding, got %+v", findings)
+	}
+}
+
+func TestRunDoctorRevisionMismatch(t *testing.T) {
+	manifestsPath := t.TempDir()
+	staticPodResourcesPath := t.TempDir()
+
+	revisionDir := path.Join(staticPodResourcesPath, "kube-apiserver-pod-9")
+	if err := os.MkdirAll(revisionDir, 0755); err != nil {
+		t.Fatalf("failed to create the revision directory: %v", err)
+	}
+	// the directory name says revision 9, but the manifest inside claims revision 8.
+	if err := os.WriteFile(path.Join(revisionDir, "kube-apiserver-pod.yaml"), []byte(simulationPodManifest("kube-apiserver", 8)), 0644); err != nil {
+		t.Fatalf("failed to write the revision manifest: %v", err)
+	}
+
+	findings := RunDoctor(context.Background(), DoctorOptions{
+		TargetName:             "kube-apiserver",
+		ManifestsPath:          manifestsPath,
+		StaticPodResourcesPath: staticPodResourcesPath,
+	})
+
+	found := false
+	for _, finding := range findings {
+		if finding.Check == "revision label/directory mismatch" {
+			found = true
+			if finding.Severity != DoctorCritical {
+				t.Errorf("expected a CRITICAL finding, got %s", finding.Severity)
+			}
+		}
+	}
+	if !found {
+		t.Errorf("expected a revision label/directory mismatch finding, got %+v", findings)
+	}
+}
+
+func TestRunDoctorSkipsRevisionMismatchForKubeadmLayout(t *testing.T) {
+	manifestsPath := t.TempDir()
+	staticPodResourcesPath := t.TempDir()
+
+	// a kubeadm layout doesn't use revisioned directories, so a directory that happens to look
+	// like one of openshift's shouldn't be mistaken for a mismatch.
+	revisionDir := path.Join(staticPodResourcesPath, "kube-apiserver-pod-9")
+	if err := os.MkdirAll(revisionDir, 0755); err != nil {
+		t.Fatalf("failed to create the revision directory: %v", err)
+	}
+
+	findings := RunDoctor(context.Background(), DoctorOptions{
+		TargetName:             "kube-apiserver",
+		ManifestsPath:          manifestsPath,
+		StaticPodResourcesPath: staticPodResourcesPath,
+		Layout:                 kubeadmLayout{},
+	})
+
+	for _, finding := range findings {
+		if finding.Check == "revision label/directory mismatch" {
+			t.Errorf("expected no revision mismatch findings under the kubeadm layout, got %+v", finding)
+		}
+	}
+}
+
+func TestRunDoctorFindingsSortedMostSevereFirst(t *testing.T) {
+	findings := RunDoctor(context.Background(), DoctorOptions{
+		TargetName:             "kube-apiserver",
+		ManifestsPath:          path.Join(t.TempDir(), "does-not-exist"),
+		StaticPodResourcesPath: path.Join(t.TempDir(), "also-missing"),
+		ProbeEndpoint:          "not-a-valid-url\x7f",
+	})
+
+	if len(findings) < 2 {
+		t.Fatalf("expected multiple findings, got %d", len(findings))
+	}
+	for i := 1; i < len(findings); i++ {
+		if findings[i].Severity > findings[i-1].Severity {
+			t.Errorf("findings not sorted most severe first: %+v", findings)
+		}
+	}
+}
+
+func TestDoctorSeverityString(t *testing.T) {
+	scenarios := []struct {
+		severity DoctorSeverity
+		expected string
+	}{
+		{DoctorInfo, "INFO"},
+		{DoctorWarning, "WARNING"},
+		{DoctorCritical, "CRITICAL"},
+	}
+	for _, scenario := range scenarios {
+		if got := scenario.severity.String(); got != scenario.expected {
+			t.Errorf("unexpected String() %q, expected %q", got, scenario.expected)
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package monitor
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// writeE2ERootManifest seeds target's root manifest, the file the kubelet watches, at revision,
+// mirroring what the installer's copy of the static pod resources into the manifests directory
+// would have already done in production before the monitor starts.
+func writeE2ERootManifest(t *testing.T, target *StartupMonitor, revision int) {
+	t.Helper()
+
+	if err := os.WriteFile(target.rootManifestPath(), simulationPodManifest(target.targetName, revision), 0644); err != nil {
+		t.Fatalf("failed to write the root manifest: %v", err)
+	}
+}
+
+// e2eOperand simulates a static pod's health endpoint with an httptest.Server, so a health
+// check exercises real HTTP round-tripping instead of an in-memory scripted function. Its
+// health can be toggled at any point during a test to simulate the operand becoming unhealthy.
+type e2eOperand struct {
+	server  *httptest.Server
+	healthy int32
+}
+
+// newE2EOperand starts an operand simulator whose /healthz reports healthy until told
+// otherwise.
+func newE2EOperand(t *testing.T) *e2eOperand {
+	t.Helper()
+
+	operand := &e2eOperand{healthy: 1}
+	operand.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.LoadInt32(&operand.healthy) == 1 {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(operand.server.Close)
+	return operand
+}
+
+// SetHealthy toggles the /healthz response returned to subsequent probes.
+func (o *e2eOperand) SetHealthy(healthy bool) {
+	value := int32(0)
+	if healthy {
+		value = 1
+	}
+	atomic.StoreInt32(&o.healthy, value)
+}
+
+// HealthCheck performs a real HTTP GET against /healthz, the same way a HealthChecker would
+// against a real operand, and reports whether it succeeded.
+func (o *e2eOperand) HealthCheck(ctx context.Context) (bool, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.server.URL+"/healthz", nil)
+	if err != nil {
+		return false, "", err
+	}
+	resp, err := o.server.Client().Do(req)
+	if err != nil {
+		return false, err.Error(), nil
+	}
+	defer resp.Body.Close()
+	return resp.StatusCode == http.StatusOK, "", nil
+}
+
+// e2eKubelet polls target's root manifest, like a kubelet watching its manifests directory for
+// changes to "restart" a static pod against, and records every revision it observes the root
+// manifest transition to. It is a passive observer: fallbackToPreviousRevision is what actually
+// performs the swap, this only lets a test assert the swap was visible on disk in real time.
+type e2eKubelet struct {
+	target *StartupMonitor
+
+	observedMu sync.Mutex
+	observed   []int
+}
+
+// newE2EKubelet starts polling target's root manifest at pollInterval until ctx is cancelled.
+// startingRevision is the revision the root manifest is seeded with, so only actual
+// transitions away from it are recorded, not the starting state itself.
+func newE2EKubelet(ctx context.Context, target *StartupMonitor, pollInterval time.Duration, startingRevision int) *e2eKubelet {
+	kubelet := &e2eKubelet{target: target}
+
+	go func() {
+		lastSeen := startingRevision
+		ticker := time.NewTicker(pollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				pod, err := target.readTargetPod(target.rootManifestPath())
+				if err != nil {
+					continue
+				}
+				revision, err := revisionOfPod(pod)
+				if err != nil {
+					continue
+				}
+				if revision == lastSeen {
+					continue
+				}
+				lastSeen = revision
+				kubelet.observedMu.Lock()
+				kubelet.observed = append(kubelet.observed, revision)
+				kubelet.observedMu.Unlock()
+			}
+		}
+	}()
+
+	return kubelet
+}
+
+// Observed returns every distinct revision the kubelet has seen the root manifest transition
+// to since it started polling, in order, excluding the starting revision itself.
+func (k *e2eKubelet) Observed() []int {
+	k.observedMu.Lock()
+	defer k.observedMu.Unlock()
+	observed := make([]int, len(k.observed))
+	copy(observed, k.observed)
+	return observed
+}
+
+// TestE2EHappyPathNeverTouchesTheRootManifest runs the real StartupMonitor against a real
+// filesystem and a real HTTP health endpoint that stays healthy throughout, and asserts the
+// kubelet never observes the root manifest change away from the guarded revision.
+func TestE2EHappyPathNeverTouchesTheRootManifest(t *testing.T) {
+	const currentRevision = 9
+
+	target := newIntegrationTarget(t, currentRevision)
+	writeIntegrationRevision(t, target, currentRevision)
+	writeE2ERootManifest(t, target, currentRevision)
+
+	operand := newE2EOperand(t)
+	target.healthChecker = HealthCheckerFunc(operand.HealthCheck)
+	target.probeInterval = 10 * time.Millisecond
+	target.timeout = 100 * time.Millisecond
+
+	ctx, cancel := context.WithTimeout(context.Background(), 300*time.Millisecond)
+	defer cancel()
+	kubelet := newE2EKubelet(ctx, target, 10*time.Millisecond, currentRevision)
+
+	target.Run(ctx)
+
+	if status := target.GetStatus(); status.Phase != PhaseHealthy {
+		t.Errorf("unexpected phase %v, expected %v", status.Phase, PhaseHealthy)
+	}
+	if observed := kubelet.Observed(); len(observed) != 0 {
+		t.Errorf("expected the kubelet to observe no manifest changes, got %v", observed)
+	}
+}
+
+// TestE2EFallbackOnUnhealthyProbe runs the real StartupMonitor against a real filesystem and a
+// real HTTP health endpoint that is unhealthy from the start, and asserts the kubelet observes
+// the root manifest swap to the previous revision once the probe times out.
+func TestE2EFallbackOnUnhealthyProbe(t *testing.T) {
+	const currentRevision = 9
+	const previousRevision = 8
+
+	target := newIntegrationTarget(t, currentRevision)
+	writeIntegrationRevision(t, target, previousRevision)
+	writeIntegrationRevision(t, target, currentRevision)
+	writeE2ERootManifest(t, target, currentRevision)
+
+	operand := newE2EOperand(t)
+	operand.SetHealthy(false)
+	target.healthChecker = HealthCheckerFunc(operand.HealthCheck)
+	target.probeInterval = 10 * time.Millisecond
+	target.timeout = 30 * time.Millisecond
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	kubelet := newE2EKubelet(ctx, target, 10*time.Millisecond, currentRevision)
+
+	target.Run(ctx)
+
+	if status := target.GetStatus(); status.Phase != PhaseFallback {
+		t.Errorf("unexpected phase %v, expected %v", status.Phase, PhaseFallback)
+	}
+
+	observed := kubelet.Observed()
+	if len(observed) == 0 || observed[len(observed)-1] != previousRevision {
+		t.Errorf("expected the kubelet to observe the root manifest settle on revision %d, observed %v", previousRevision, observed)
+	}
+}
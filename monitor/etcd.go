@@ -0,0 +1,75 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.etcd.io/etcd/clientv3"
+)
+
+// EtcdClientPort is etcd's secure client port, used both for the health check and for member
+// status queries in EtcdMemberSafeToFallbackGuard.
+const EtcdClientPort = 2379
+
+// defaultEtcdFallbackTimeout is the default fallback timeout for etcd. It is longer than the
+// other profiles' defaults because etcd re-initializes its raft state and re-establishes
+// quorum with its peers on restart, which routinely takes longer than an apiserver or
+// controller manager coming back up.
+const defaultEtcdFallbackTimeout = 5 * time.Minute
+
+// EtcdMemberSafeToFallbackGuard returns a FallbackGuardFunc that vetoes a fallback unless a
+// quorum of etcd's voting members is currently reachable. Rolling the local member back to an
+// earlier revision while the cluster lacks quorum risks compounding an outage instead of
+// recovering from one: the member being rolled back can't be safely restarted and rejoined
+// without the rest of the cluster agreeing on the current state. client must be connected to
+// the local etcd member.
+func EtcdMemberSafeToFallbackGuard(client *clientv3.Client, requestTimeout time.Duration) FallbackGuardFunc {
+	return func() (bool, string) {
+		listCtx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+		defer cancel()
+
+		members, err := client.MemberList(listCtx)
+		if err != nil {
+			return false, fmt.Sprintf("unable to list etcd members, refusing to fall back: %v", err)
+		}
+
+		healthy := 0
+		for _, member := range members.Members {
+			if len(member.ClientURLs) == 0 {
+				// a member that hasn't finished joining yet doesn't have a client URL and
+				// doesn't count towards quorum either way.
+				continue
+			}
+			statusCtx, statusCancel := context.WithTimeout(context.Background(), requestTimeout)
+			_, err := client.Status(statusCtx, member.ClientURLs[0])
+			statusCancel()
+			if err == nil {
+				healthy++
+			}
+		}
+
+		quorum := len(members.Members)/2 + 1
+		if healthy < quorum {
+			return false, fmt.Sprintf("only %d/%d etcd members are healthy, below the quorum of %d required to safely fall back", healthy, len(members.Members), quorum)
+		}
+		return true, ""
+	}
+}
+
+// EtcdProfile bundles the health check, member data safety guard, and fallback timeout default
+// for monitoring etcd, so its operator can adopt the startup-monitor with configuration only:
+//
+//	monitor.NewStartupMonitorCommand(monitor.EtcdProfile(etcdClient)...)
+//
+// etcdClient must be connected to the local etcd member; the guard uses it to confirm quorum
+// is intact before ever rolling the member back. etcd's manifest naming (etcd-pod.yaml) needs
+// no special casing here: it follows the same generic, target-name-driven layout as
+// kube-apiserver, KCM and the scheduler.
+func EtcdProfile(etcdClient *clientv3.Client) []Option {
+	return []Option{
+		WithHealthCheck(httpsHealthChecker(EtcdClientPort, "/health")),
+		WithFallbackGuardDefault(EtcdMemberSafeToFallbackGuard(etcdClient, 5*time.Second)),
+		WithFallbackTimeoutDefault(defaultEtcdFallbackTimeout),
+	}
+}
@@ -0,0 +1,110 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// eventSourceComponent identifies this process as the source of events it emits, so cluster
+// admins can distinguish a startup-monitor fallback from a rollback initiated some other way.
+const eventSourceComponent = "startup-monitor"
+
+// recordFallbackEvent emits a Warning event recording that this node fell back from
+// fromRevision to toRevision, mirroring the message patchOperatorFallbackCondition reports at
+// the cluster level. See recordEvent for delivery semantics.
+func (sm *StartupMonitor) recordFallbackEvent(ctx context.Context, fromRevision, toRevision int, reason FallbackReason, diagnostics string) error {
+	nodeName, err := nodeName()
+	if err != nil {
+		return fmt.Errorf("failed to determine the node name: %v", err)
+	}
+
+	message := fmt.Sprintf("node %q fell back %s from revision %d to revision %d", nodeName, sm.targetName, fromRevision, toRevision)
+	if len(diagnostics) > 0 {
+		message += fmt.Sprintf(": %s", diagnostics)
+	}
+	return sm.recordEvent(ctx, corev1.EventTypeWarning, string(reason), message)
+}
+
+// recordHealthyEvent emits a Normal event recording that revision was observed healthy and
+// promoted to the last known good revision. See recordEvent for delivery semantics.
+func (sm *StartupMonitor) recordHealthyEvent(ctx context.Context, revision int) error {
+	message := fmt.Sprintf("%s revision %d is healthy and was promoted to the last known good revision", sm.targetName, revision)
+	return sm.recordEvent(ctx, corev1.EventTypeNormal, "RevisionHealthy", message)
+}
+
+// recordEvent creates a corev1 Event in sm.targetNamespace against the target's mirror pod, or
+// against the node object if the mirror pod can't be found, so cluster admins have a
+// cluster-visible trail of what would otherwise be a silent, node-local decision.
+//
+// This is a best-effort, additional signal, mirroring recordFallback: it is skipped entirely
+// when no client is configured, and its errors never fail the calling sync iteration since a
+// missed event is far less costly than an interrupted rollback.
+func (sm *StartupMonitor) recordEvent(ctx context.Context, eventType, reason, message string) error {
+	if !sm.hasClient() {
+		return nil
+	}
+
+	nodeName, err := nodeName()
+	if err != nil {
+		return fmt.Errorf("failed to determine the node name: %v", err)
+	}
+
+	involvedObject, err := sm.eventInvolvedObject(ctx, nodeName)
+	if err != nil {
+		return err
+	}
+
+	eventNamespace := involvedObject.Namespace
+	if len(eventNamespace) == 0 {
+		// the node object is cluster-scoped; events attached to it live in the default
+		// namespace, matching what the kubelet does for node-level events.
+		eventNamespace = metav1.NamespaceDefault
+	}
+
+	now := metav1.Now()
+	event := &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: fmt.Sprintf("%s-", sm.targetName),
+			Namespace:    eventNamespace,
+		},
+		InvolvedObject: *involvedObject,
+		Reason:         reason,
+		Message:        message,
+		Type:           eventType,
+		Source:         corev1.EventSource{Component: eventSourceComponent, Host: nodeName},
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Count:          1,
+	}
+
+	_, err = sm.client.CoreV1().Events(eventNamespace).Create(ctx, event, metav1.CreateOptions{})
+	return err
+}
+
+// eventInvolvedObject resolves the object a recordEvent call should be attached to: the
+// target's mirror pod when it can be found, falling back to the node object otherwise, so
+// "kubectl describe" on either surfaces the monitor's activity.
+func (sm *StartupMonitor) eventInvolvedObject(ctx context.Context, nodeName string) (*corev1.ObjectReference, error) {
+	mirrorPodName := fmt.Sprintf("%s-%s", sm.targetName, nodeName)
+	if pod, err := sm.client.CoreV1().Pods(sm.targetNamespace).Get(ctx, mirrorPodName, metav1.GetOptions{}); err == nil {
+		return &corev1.ObjectReference{
+			Kind:      "Pod",
+			Namespace: pod.Namespace,
+			Name:      pod.Name,
+			UID:       pod.UID,
+		}, nil
+	}
+
+	node, err := sm.client.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve an event target for %s: neither the mirror pod nor node %q could be found: %v", sm.targetName, nodeName, err)
+	}
+	return &corev1.ObjectReference{
+		Kind: "Node",
+		Name: node.Name,
+		UID:  node.UID,
+	}, nil
+}
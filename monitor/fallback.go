@@ -0,0 +1,133 @@
+package monitor
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/library-go/pkg/operator/resource/resourceread"
+	"k8s.io/apimachinery/pkg/util/uuid"
+)
+
+// FallbackOptions configures the "fallback" subcommand.
+type FallbackOptions struct {
+	// TargetName is the operand name, e.g. "kube-apiserver".
+	TargetName string
+
+	// ManifestsPath is the directory holding the root manifest.
+	ManifestsPath string
+
+	// StaticPodResourcesPath is the directory holding revisioned manifests.
+	StaticPodResourcesPath string
+
+	// Layout is the directory scheme in use. Defaults to openshiftLayout.
+	Layout Layout
+
+	// ToRevision is the revisioned manifest to fall back to.
+	ToRevision int
+
+	// DryRun, when true, reports what would be written without touching any manifest.
+	DryRun bool
+
+	// AnnotationPrefix overrides the annotation domain prefix applied to the fallback pod's
+	// manifest. Left empty, the default, defaultAnnotationPrefix is used.
+	AnnotationPrefix string
+
+	// Reason optionally records why the fallback was performed, applied as the
+	// fallback-reason annotation. Left empty, the default, no reason annotation is applied.
+	Reason string
+}
+
+// RunFallback performs the same annotated manifest swap fallbackToPreviousRevision performs on
+// a timeout, but on demand and against an explicitly chosen revision rather than the last known
+// good one, so a cluster admin can force a node back to a known-good revision during incident
+// response without waiting for the timeout machinery. With DryRun set, it reports the manifest
+// it would write without writing it.
+func RunFallback(opts FallbackOptions) error {
+	if opts.Layout == nil {
+		opts.Layout = openshiftLayout{}
+	}
+
+	revisionManifestPath := opts.Layout.TargetManifestPathFor(opts.StaticPodResourcesPath, opts.TargetName, opts.ToRevision)
+	rawManifest, err := os.ReadFile(revisionManifestPath)
+	if err != nil {
+		return fmt.Errorf("failed to read the manifest for revision %d at %q: %v", opts.ToRevision, revisionManifestPath, err)
+	}
+	pod, err := resourceread.ReadPodV1(rawManifest)
+	if err != nil {
+		return fmt.Errorf("failed to parse the manifest for revision %d at %q: %v", opts.ToRevision, revisionManifestPath, err)
+	}
+
+	if pod.Annotations == nil {
+		pod.Annotations = map[string]string{}
+	}
+	pod.Annotations[annotationKey(opts.AnnotationPrefix, fallbackForRevisionAnnotationSuffix)] = fmt.Sprintf("%d", opts.ToRevision)
+	pod.Annotations[annotationKey(opts.AnnotationPrefix, fallbackTimestampAnnotationSuffix)] = time.Now().UTC().Format(time.RFC3339)
+	pod.Annotations[annotationKey(opts.AnnotationPrefix, monitorVersionAnnotationSuffix)] = Version
+	if opts.Reason != "" {
+		pod.Annotations[annotationKey(opts.AnnotationPrefix, fallbackReasonAnnotationSuffix)] = opts.Reason
+	}
+
+	// the kubelet has a bug that prevents graceful termination from working on static pods
+	// with the same name, filename and uuid. By setting the pod UID we can work around the
+	// kubelet bug and get our graceful termination honored. Per the node team, this is hard
+	// to fix in the kubelet, though it will affect all static pods.
+	pod.UID = uuid.NewUUID()
+
+	rootManifestPath := opts.Layout.RootManifestPath(opts.ManifestsPath, opts.TargetName)
+	podBytes := []byte(resourceread.WritePodV1OrDie(pod))
+
+	if opts.DryRun {
+		fmt.Printf("Would write the manifest for revision %d to %q:\n%s", opts.ToRevision, rootManifestPath, podBytes)
+		return nil
+	}
+
+	if err := os.Remove(rootManifestPath); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove the existing manifest %q: %v", rootManifestPath, err)
+	}
+	if err := os.WriteFile(rootManifestPath, podBytes, 0644); err != nil {
+		return fmt.Errorf("failed to write the manifest for revision %d to %q: %v", opts.ToRevision, rootManifestPath, err)
+	}
+
+	fmt.Printf("Wrote the manifest for revision %d to %q\n", opts.ToRevision, rootManifestPath)
+	return nil
+}
+
+// newFallbackCommand builds the "fallback" subcommand, which forces a manual rollback to a
+// specific revision, without running the monitor itself.
+func newFallbackCommand() *cobra.Command {
+	opts := FallbackOptions{}
+	var layoutName string
+
+	cmd := &cobra.Command{
+		Use:   "fallback",
+		Short: "Forces a manual rollback to a specific revision.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			layout, err := layoutForName(layoutName)
+			if err != nil {
+				return err
+			}
+			opts.Layout = layout
+
+			return RunFallback(opts)
+		},
+	}
+
+	fs := cmd.Flags()
+	fs.StringVar(&opts.TargetName, "target-name", "", "name of the operand, e.g. \"kube-apiserver\" (required)")
+	fs.StringVar(&opts.ManifestsPath, "manifests-path", "", "directory holding the root manifest (required)")
+	fs.StringVar(&opts.StaticPodResourcesPath, "static-pod-resources-path", "", "directory holding revisioned manifests (required)")
+	fs.StringVar(&layoutName, "layout", "", "directory scheme used to locate manifests: \"openshift\" (default) or \"kubeadm\"")
+	fs.IntVar(&opts.ToRevision, "to-revision", 0, "revision to fall back to (required)")
+	fs.BoolVar(&opts.DryRun, "dry-run", false, "report the manifest that would be written without writing it")
+	fs.StringVar(&opts.AnnotationPrefix, "annotation-prefix", "", "annotation domain prefix applied to the fallback pod's manifest. If unset, defaults to \"startup-monitor.static-pods.openshift.io\".")
+	fs.StringVar(&opts.Reason, "reason", "", "reason to record in the fallback-reason annotation. If unset, no reason annotation is applied.")
+	_ = cmd.MarkFlagRequired("target-name")
+	_ = cmd.MarkFlagRequired("manifests-path")
+	_ = cmd.MarkFlagRequired("static-pod-resources-path")
+	_ = cmd.MarkFlagRequired("to-revision")
+
+	return cmd
+}
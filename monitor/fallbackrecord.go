@@ -0,0 +1,83 @@
+package monitor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// fallbackRecordConfigMapName returns the name of the durable, per-node fallback record for
+// targetName on the given node.
+func fallbackRecordConfigMapName(targetName, nodeName string) string {
+	return fmt.Sprintf("%s-startup-monitor-fallback-%s", targetName, nodeName)
+}
+
+// recordFallback creates or updates a durable, per-node ConfigMap in sm.targetNamespace
+// recording that this node fell back from fromRevision to toRevision. The operator watches
+// these config maps to learn which nodes have a failed revision, so it can stop re-rolling
+// that revision onto them, closing the loop between node-local rollback and cluster-level
+// revision management.
+//
+// This is a best-effort, additional signal: it is skipped entirely when no client is
+// configured, in which case the node-local rollback still takes effect on its own.
+func (sm *StartupMonitor) recordFallback(ctx context.Context, fromRevision, toRevision int, diagnostics string) error {
+	if !sm.hasClient() {
+		return nil
+	}
+
+	nodeName, err := nodeName()
+	if err != nil {
+		return fmt.Errorf("failed to determine the node name: %v", err)
+	}
+
+	data := map[string]string{
+		"node":         nodeName,
+		"target":       sm.targetName,
+		"fromRevision": strconv.Itoa(fromRevision),
+		"toRevision":   strconv.Itoa(toRevision),
+		"fallbackTime": time.Now().UTC().Format(time.RFC3339),
+	}
+	if len(diagnostics) > 0 {
+		data["diagnostics"] = diagnostics
+	}
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fallbackRecordConfigMapName(sm.targetName, nodeName),
+			Namespace: sm.targetNamespace,
+			Labels: map[string]string{
+				"startup-monitor.static-pods.openshift.io/target": sm.targetName,
+				"startup-monitor.static-pods.openshift.io/node":   nodeName,
+			},
+		},
+		Data: data,
+	}
+
+	configMaps := sm.client.CoreV1().ConfigMaps(sm.targetNamespace)
+	if _, err := configMaps.Create(ctx, configMap, metav1.CreateOptions{}); err != nil {
+		if !apierrors.IsAlreadyExists(err) {
+			return err
+		}
+		// the ConfigMap already exists from a previous fallback; merge-patch its labels and
+		// data instead of Update, which would require a ResourceVersion we don't have without
+		// an extra Get, and would be rejected by a real apiserver with an empty one.
+		patch, err := json.Marshal(map[string]interface{}{
+			"metadata": map[string]interface{}{"labels": configMap.Labels},
+			"data":     configMap.Data,
+		})
+		if err != nil {
+			return err
+		}
+		if _, err := configMaps.Patch(ctx, configMap.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -9,6 +9,9 @@ import (
 	"k8s.io/apimachinery/pkg/util/wait"
 )
 
+// installerLockPollInterval is how often TryLock retries an already-held lock.
+const installerLockPollInterval = 300 * time.Millisecond
+
 // FLock a type that supports file locking to coordinate work between processes
 type FLock struct {
 	locker sync.Mutex
@@ -40,7 +43,7 @@ func (f *FLock) TryLock(timeout time.Duration) error {
 	if err := f.openLockFile(); err != nil {
 		return err
 	}
-	if err := wait.Poll(300*time.Millisecond, timeout, f.tryLock); err != nil {
+	if err := wait.Poll(installerLockPollInterval, timeout, f.tryLock); err != nil {
 		if closeErr := f.closeLockedFile(); closeErr != nil {
 			return closeErr
 		}
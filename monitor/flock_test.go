@@ -0,0 +1,65 @@
+package monitor
+
+import (
+	"path"
+	"testing"
+	"time"
+)
+
+func TestFLockTryLockAndUnlock(t *testing.T) {
+	lockPath := path.Join(t.TempDir(), "test.lock")
+	lock := NewFLock(lockPath)
+
+	if err := lock.TryLock(time.Second); err != nil {
+		t.Fatalf("failed to acquire the lock: %v", err)
+	}
+	if err := lock.Unlock(); err != nil {
+		t.Fatalf("failed to release the lock: %v", err)
+	}
+}
+
+func TestFLockTryLockTimesOutWhileHeld(t *testing.T) {
+	lockPath := path.Join(t.TempDir(), "test.lock")
+
+	holder := NewFLock(lockPath)
+	if err := holder.TryLock(time.Second); err != nil {
+		t.Fatalf("failed to acquire the lock: %v", err)
+	}
+	defer func() {
+		if err := holder.Unlock(); err != nil {
+			t.Errorf("failed to release the lock: %v", err)
+		}
+	}()
+
+	contender := NewFLock(lockPath)
+	if err := contender.TryLock(500 * time.Millisecond); err == nil {
+		t.Errorf("expected TryLock to time out while the lock is held by another instance")
+	}
+}
+
+func TestFLockTryLockSucceedsOnceReleased(t *testing.T) {
+	lockPath := path.Join(t.TempDir(), "test.lock")
+
+	holder := NewFLock(lockPath)
+	if err := holder.TryLock(time.Second); err != nil {
+		t.Fatalf("failed to acquire the lock: %v", err)
+	}
+
+	released := make(chan struct{})
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		if err := holder.Unlock(); err != nil {
+			t.Errorf("failed to release the lock: %v", err)
+		}
+		close(released)
+	}()
+
+	contender := NewFLock(lockPath)
+	if err := contender.TryLock(2 * time.Second); err != nil {
+		t.Fatalf("expected TryLock to succeed once the lock is released, got %v", err)
+	}
+	<-released
+	if err := contender.Unlock(); err != nil {
+		t.Errorf("failed to release the lock: %v", err)
+	}
+}
@@ -0,0 +1,66 @@
+package monitor
+
+import (
+	"os"
+	"testing"
+
+	"github.com/p0lyn0mial/startup-monitor/monitor/monitortesting"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FuzzScanPreviousRevision hardens scanPreviousRevision's directory-name parsing against hostile
+// or corrupted entries under the static pod resources directory: a single misnamed directory
+// (e.g. dropped by a botched upgrade or an attacker with write access to the node) must not panic
+// the monitor, whatever it does to the fallback decision.
+func FuzzScanPreviousRevision(f *testing.F) {
+	f.Add("kube-apiserver-pod-8")
+	f.Add("kube-apiserver-pod--1")
+	f.Add("kube-apiserver-pod-")
+	f.Add("kube-apiserver-pod-99999999999999999999999999999999")
+	f.Add("kube-apiserver-pod-8-pod-9")
+	f.Add("kube-apiserver")
+	f.Add("")
+
+	f.Fuzz(func(t *testing.T, name string) {
+		target := New(nil)
+		target.targetName = "kube-apiserver"
+		target.staticPodResourcesPath = "/etc/kubernetes/static-pod-resources"
+		target.io = &monitortesting.FakeIO{
+			ReadDirFn: func(string) ([]os.FileInfo, error) {
+				return []os.FileInfo{monitortesting.FakeDir(name)}, nil
+			},
+		}
+
+		// scanPreviousRevision may legitimately return an error for a malformed name; it must
+		// never panic.
+		_, _, _ = target.scanPreviousRevision()
+	})
+}
+
+// FuzzRevisionOfPod hardens revisionOfPod's revision-label handling against a hostile or
+// corrupted manifest's label value: whatever loadRootTargetPodAndExtractRevision is handed, it
+// must return an error rather than panic.
+func FuzzRevisionOfPod(f *testing.F) {
+	f.Add("8")
+	f.Add("")
+	f.Add("-1")
+	f.Add("008")
+	f.Add("99999999999999999999999999999999")
+	f.Add("0x8")
+	f.Add("8\n")
+	f.Add(" 8")
+
+	f.Fuzz(func(t *testing.T, revisionLabel string) {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "kube-apiserver",
+				Labels: map[string]string{"revision": revisionLabel},
+			},
+		}
+
+		// revisionOfPod may legitimately return an error for a malformed label; it must never
+		// panic.
+		_, _ = revisionOfPod(pod)
+	})
+}
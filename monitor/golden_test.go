@@ -0,0 +1,94 @@
+package monitor
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path"
+	"regexp"
+	"testing"
+)
+
+// updateGolden regenerates the golden files compared against in TestFallbackManifestGolden when
+// run as `go test ./monitor/... -run TestFallbackManifestGolden -update-golden`, instead of
+// failing on a legitimate, reviewed formatting change.
+var updateGolden = flag.Bool("update-golden", false, "update golden files instead of comparing against them")
+
+// uidFieldPattern matches the pod UID JSON member so it can be normalized before comparison:
+// fallbackToPreviousRevision assigns a fresh, random UID on every call (see the UID workaround
+// for a kubelet static pod graceful-termination bug), so the raw bytes are never byte-for-byte
+// reproducible without normalizing it away first.
+var uidFieldPattern = regexp.MustCompile(`"uid":"[^"]*"`)
+
+// fallbackTimestampFieldPattern matches the fallback-timestamp annotation value so it can be
+// normalized before comparison: fallbackToPreviousRevision stamps it with the current wall
+// clock time on every call, so the raw bytes are never byte-for-byte reproducible without
+// normalizing it away first, just like the UID.
+var fallbackTimestampFieldPattern = regexp.MustCompile(`"startup-monitor\.static-pods\.openshift\.io/fallback-timestamp":"[^"]*"`)
+
+// normalizeFallbackManifest replaces the random pod UID and the current-time fallback-timestamp
+// annotation fallbackToPreviousRevision assigns with fixed placeholders, so the remaining bytes
+// -- annotations, field ordering, everything else resourceread.WritePodV1OrDie produces -- can
+// be compared byte-for-byte against a golden file.
+func normalizeFallbackManifest(manifest []byte) []byte {
+	manifest = uidFieldPattern.ReplaceAll(manifest, []byte(`"uid":"00000000-0000-0000-0000-000000000000"`))
+	manifest = fallbackTimestampFieldPattern.ReplaceAll(manifest, []byte(`"startup-monitor.static-pods.openshift.io/fallback-timestamp":"1970-01-01T00:00:00Z"`))
+	return manifest
+}
+
+// TestFallbackManifestGolden exercises fallbackToPreviousRevision against realFS and a real,
+// full-sized static pod manifest (the same fixture TestLoadTargetManifestAndExtractRevision
+// reads), and compares the exact bytes written for the root manifest -- annotations, UID
+// normalized, field ordering -- against a checked-in golden file, so a formatting or
+// serialization regression in resourceread.WritePodV1OrDie or the annotation/label merge logic
+// is caught even though it wouldn't otherwise fail any other test.
+//
+// Run with -update-golden to regenerate the golden file after a reviewed, intentional change.
+func TestFallbackManifestGolden(t *testing.T) {
+	previousRevisionManifest, err := os.ReadFile("testdata/scenario-1-pod.yaml")
+	if err != nil {
+		t.Fatalf("failed to read the fixture: %v", err)
+	}
+
+	target := newIntegrationTarget(t, 9)
+	writeIntegrationRevision(t, target, 9)
+
+	previousRevisionPath := target.targetManifestPathFor(8)
+	if err := os.MkdirAll(path.Dir(previousRevisionPath), 0755); err != nil {
+		t.Fatalf("failed to create the revision 8 directory: %v", err)
+	}
+	if err := os.WriteFile(previousRevisionPath, previousRevisionManifest, 0644); err != nil {
+		t.Fatalf("failed to write the revision 8 fixture manifest: %v", err)
+	}
+
+	rootManifestPath := target.rootManifestPath()
+	if err := os.WriteFile(rootManifestPath, previousRevisionManifest, 0644); err != nil {
+		t.Fatalf("failed to write the root manifest: %v", err)
+	}
+
+	if err := target.fallbackToPreviousRevision(""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got, err := os.ReadFile(rootManifestPath)
+	if err != nil {
+		t.Fatalf("failed to read the fallback manifest: %v", err)
+	}
+	got = normalizeFallbackManifest(got)
+
+	const goldenPath = "testdata/fallback-golden-scenario-1.yaml"
+	if *updateGolden {
+		if err := os.WriteFile(goldenPath, got, 0644); err != nil {
+			t.Fatalf("failed to update the golden file: %v", err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(goldenPath)
+	if err != nil {
+		t.Fatalf("failed to read the golden file: %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("fallback manifest doesn't match the golden file %s; rerun with -update-golden after reviewing the diff\ngot:\n%s\nwant:\n%s", goldenPath, got, want)
+	}
+}
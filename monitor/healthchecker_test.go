@@ -0,0 +1,161 @@
+package monitor
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestHealthCheckerFunc(t *testing.T) {
+	fn := HealthCheckerFunc(func(ctx context.Context) (bool, string, error) {
+		return false, "not ready yet", nil
+	})
+
+	healthy, reason, err := fn.Check(context.Background())
+	if healthy {
+		t.Errorf("expected unhealthy")
+	}
+	if reason != "not ready yet" {
+		t.Errorf("unexpected reason %q", reason)
+	}
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestHttpsHealthChecker(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/healthz" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	port, err := serverPort(server)
+	if err != nil {
+		t.Fatalf("failed to determine the test server's port: %v", err)
+	}
+
+	if healthy, _, err := httpsHealthChecker(port, "/healthz").Check(context.Background()); err != nil || !healthy {
+		t.Errorf("expected /healthz to report healthy, got healthy=%t err=%v", healthy, err)
+	}
+	if healthy, reason, err := httpsHealthChecker(port, "/not-found").Check(context.Background()); err != nil || healthy || reason == "" {
+		t.Errorf("expected /not-found to report unhealthy with a reason, got healthy=%t reason=%q err=%v", healthy, reason, err)
+	}
+}
+
+func TestHttpsHealthCheckerHonorsContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// a cancelled context aborts the GET before it completes; the resulting transport error is
+	// reported as unhealthy with a reason, not as a Check error, the same way an unreachable
+	// operand is.
+	healthy, reason, err := httpsHealthChecker(0, "/healthz").Check(ctx)
+	if healthy || reason == "" || err != nil {
+		t.Errorf("expected an unhealthy result with a reason and no error, got healthy=%t reason=%q err=%v", healthy, reason, err)
+	}
+}
+
+func TestExecHealthChecker(t *testing.T) {
+	if healthy, _, err := execHealthChecker("true", time.Second).Check(context.Background()); err != nil || !healthy {
+		t.Errorf("expected \"true\" to report healthy, got healthy=%t err=%v", healthy, err)
+	}
+	if healthy, reason, err := execHealthChecker("echo failed 1>&2; false", time.Second).Check(context.Background()); err != nil || healthy || reason == "" {
+		t.Errorf("expected \"false\" to report unhealthy with a reason, got healthy=%t reason=%q err=%v", healthy, reason, err)
+	}
+}
+
+func TestExecHealthCheckerHonorsTimeout(t *testing.T) {
+	healthy, reason, err := execHealthChecker("sleep 5", 50*time.Millisecond).Check(context.Background())
+	if healthy || reason == "" || err != nil {
+		t.Errorf("expected a timed-out command to report unhealthy with a reason and no error, got healthy=%t reason=%q err=%v", healthy, reason, err)
+	}
+}
+
+func TestTCPHealthChecker(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to start a test listener: %v", err)
+	}
+	defer listener.Close()
+
+	if healthy, _, err := tcpHealthChecker(listener.Addr().String(), time.Second).Check(context.Background()); err != nil || !healthy {
+		t.Errorf("expected the listening address to report healthy, got healthy=%t err=%v", healthy, err)
+	}
+
+	if healthy, reason, err := tcpHealthChecker("127.0.0.1:0", time.Second).Check(context.Background()); err != nil || healthy || reason == "" {
+		t.Errorf("expected an unreachable address to report unhealthy with a reason, got healthy=%t reason=%q err=%v", healthy, reason, err)
+	}
+}
+
+func TestEvaluateKubeletPodHealth(t *testing.T) {
+	readyPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "test-target-node1"},
+		Status: corev1.PodStatus{
+			Conditions:        []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+			ContainerStatuses: []corev1.ContainerStatus{{Name: "test-target", RestartCount: 2}},
+		},
+	}
+
+	if healthy, reason, err := evaluateKubeletPodHealth(readyPod, -1); err != nil || !healthy || reason != "" {
+		t.Errorf("expected a Ready pod with restart checking disabled to report healthy, got healthy=%t reason=%q err=%v", healthy, reason, err)
+	}
+	if healthy, reason, err := evaluateKubeletPodHealth(readyPod, 1); err != nil || healthy || reason == "" {
+		t.Errorf("expected a Ready pod exceeding the restart limit to report unhealthy with a reason, got healthy=%t reason=%q err=%v", healthy, reason, err)
+	}
+
+	notReadyPod := readyPod.DeepCopy()
+	notReadyPod.Status.Conditions[0].Status = corev1.ConditionFalse
+	if healthy, reason, err := evaluateKubeletPodHealth(notReadyPod, -1); err != nil || healthy || reason == "" {
+		t.Errorf("expected a non-Ready pod to report unhealthy with a reason, got healthy=%t reason=%q err=%v", healthy, reason, err)
+	}
+}
+
+func TestHealthCheckerForNameBuiltins(t *testing.T) {
+	for _, name := range []string{"kube-apiserver-readyz", "kube-controller-manager-healthz", "kube-scheduler-healthz", "etcd-health"} {
+		if _, err := healthCheckerForName(name); err != nil {
+			t.Errorf("expected %q to be registered, got %v", name, err)
+		}
+	}
+}
+
+func TestHealthCheckerForNameUnknown(t *testing.T) {
+	if _, err := healthCheckerForName("does-not-exist"); err == nil {
+		t.Errorf("expected an error for an unregistered name")
+	}
+}
+
+func TestRegisterHealthChecker(t *testing.T) {
+	sentinel := errors.New("sentinel")
+	RegisterHealthChecker("test-checker", func() HealthChecker {
+		return HealthCheckerFunc(func(ctx context.Context) (bool, string, error) { return false, "", sentinel })
+	})
+
+	checker, err := healthCheckerForName("test-checker")
+	if err != nil {
+		t.Fatalf("unexpected error resolving the registered checker: %v", err)
+	}
+	if _, _, err := checker.Check(context.Background()); !errors.Is(err, sentinel) {
+		t.Errorf("expected the registered factory's checker to be returned, got err=%v", err)
+	}
+}
+
+// serverPort extracts the TCP port an httptest.Server is listening on.
+func serverPort(server *httptest.Server) (int, error) {
+	_, portStr, err := net.SplitHostPort(server.Listener.Addr().String())
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(portStr)
+}
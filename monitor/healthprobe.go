@@ -0,0 +1,50 @@
+package monitor
+
+import (
+	"sync"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// healthProbeCache holds the most recently published result of calling healthChecker, so
+// the sync loop can read it without ever calling healthChecker itself. healthChecker is
+// documented as caller-supplied and expected to be cheap, but a misbehaving or hung
+// implementation must not be able to stall the sync loop past the fallback deadline or delay
+// reaction to a manifest change; running it from its own goroutine and publishing here
+// bounds the blast radius of a slow probe to the probe itself.
+type healthProbeCache struct {
+	lock    sync.Mutex
+	healthy bool
+	probed  bool
+}
+
+// set publishes the result of the most recent healthChecker call. It is safe for
+// concurrent use.
+func (c *healthProbeCache) set(healthy bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.healthy = healthy
+	c.probed = true
+}
+
+// get returns the most recently published result, and whether a result has been published
+// yet. It is safe for concurrent use.
+func (c *healthProbeCache) get() (healthy, probed bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.healthy, c.probed
+}
+
+// runHealthProbeLoop calls sm.probeHealth every sm.probeInterval, publishing each result to
+// sm.probeCache, until stopCh is closed. It runs on its own goroutine, decoupled from the sync
+// loop, so a slow or hung healthChecker only delays the next probe, never a sync.
+func (sm *StartupMonitor) runHealthProbeLoop(stopCh <-chan struct{}) {
+	wait.JitterUntil(func() {
+		// once asleep (see WithSleepMode), the target's health no longer affects anything the
+		// sync loop does, so stop probing it instead of paying for probes nobody reads.
+		if sm.sleepInsteadOfSelfRemoval && sm.getPhase() == PhaseHealthy {
+			return
+		}
+		sm.probeCache.set(sm.probeHealth())
+	}, sm.probeInterval, loopJitterFactor, true, stopCh)
+}
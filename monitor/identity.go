@@ -0,0 +1,25 @@
+package monitor
+
+import (
+	"fmt"
+	"os"
+)
+
+// NodeNameEnvVar is the environment variable, typically populated via the downward API
+// (fieldRef: spec.nodeName) in the monitor's own pod spec, identifying the node this process
+// runs on. It takes precedence over the process hostname, which inside a container reflects
+// the pod's hostname and isn't guaranteed to match the node name.
+const NodeNameEnvVar = "NODE_NAME"
+
+// nodeName returns the name of the node this process runs on, preferring NodeNameEnvVar over
+// the process hostname.
+func nodeName() (string, error) {
+	if name := os.Getenv(NodeNameEnvVar); len(name) > 0 {
+		return name, nil
+	}
+	name, err := os.Hostname()
+	if err != nil {
+		return "", fmt.Errorf("failed to determine the node name: %v", err)
+	}
+	return name, nil
+}
@@ -0,0 +1,1089 @@
+package monitor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// integrationPodManifest renders a minimal static pod manifest carrying the given revision
+// label, matching what revisionOfPod expects to find.
+func integrationPodManifest(revision int) string {
+	return fmt.Sprintf(`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: kube-apiserver
+  labels:
+    revision: "%d"
+`, revision)
+}
+
+// integrationPodManifestWithHostPath renders a minimal static pod manifest carrying the given
+// revision label and a single host path volume, matching what snapshotHostPathVolumes and
+// restoreHostPathVolumes expect to find.
+func integrationPodManifestWithHostPath(revision int, volumeName, hostPath string) string {
+	return fmt.Sprintf(`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: kube-apiserver
+  labels:
+    revision: "%d"
+spec:
+  volumes:
+  - name: %s
+    hostPath:
+      path: %s
+`, revision, volumeName, hostPath)
+}
+
+// integrationPodManifestWithDependentFile renders a minimal static pod manifest carrying the
+// given revision label and a dependentFilesAnnotation, matching what archiveKnownGoodSnapshot
+// expects to find.
+func integrationPodManifestWithDependentFile(revision int, dependentPath string) string {
+	return fmt.Sprintf(`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: kube-apiserver
+  labels:
+    revision: "%d"
+  annotations:
+    startup-monitor.static-pods.openshift.io/dependent-files: %s
+`, revision, dependentPath)
+}
+
+// newIntegrationTarget builds a StartupMonitor wired to realFS and a fresh directory layout
+// under t.TempDir(), mirroring the on-disk shape the kubelet and installer maintain in
+// production: manifestsPath holds the root manifest the kubelet watches, staticPodResourcesPath
+// holds the revisioned resource directories.
+func newIntegrationTarget(t *testing.T, revision int) *StartupMonitor {
+	t.Helper()
+
+	root := t.TempDir()
+	manifestsPath := path.Join(root, "manifests")
+	staticPodResourcesPath := path.Join(root, "static-pod-resources")
+	if err := os.MkdirAll(manifestsPath, 0755); err != nil {
+		t.Fatalf("failed to create manifestsPath: %v", err)
+	}
+	if err := os.MkdirAll(staticPodResourcesPath, 0755); err != nil {
+		t.Fatalf("failed to create staticPodResourcesPath: %v", err)
+	}
+
+	target := New(nil)
+	target.io = realFS{}
+	target.revision = revision
+	target.targetName = "kube-apiserver"
+	target.manifestsPath = manifestsPath
+	target.staticPodResourcesPath = staticPodResourcesPath
+	return target
+}
+
+// writeIntegrationRevision writes revision's resource directory and pod manifest under
+// target's staticPodResourcesPath, mirroring what the installer pod writes before a revision
+// is rolled out.
+func writeIntegrationRevision(t *testing.T, target *StartupMonitor, revision int) {
+	t.Helper()
+
+	manifestPath := target.targetManifestPathFor(revision)
+	if err := os.MkdirAll(path.Dir(manifestPath), 0755); err != nil {
+		t.Fatalf("failed to create revision directory for %d: %v", revision, err)
+	}
+	if err := os.WriteFile(manifestPath, []byte(integrationPodManifest(revision)), 0644); err != nil {
+		t.Fatalf("failed to write revision manifest for %d: %v", revision, err)
+	}
+}
+
+// TestIntegrationCreateLastKnowGoodRevisionAndDestroy exercises createLastKnowGoodRevisionAndDestroy
+// against a real temp filesystem: a real symlink is created pointing at the guarded revision, and
+// the monitor's own static pod manifest is really removed, catching issues fakeIO's in-memory
+// stand-ins can't, such as a dangling symlink or a real EXDEV-only-in-production fallback path
+// silently miscompiling.
+func TestIntegrationCreateLastKnowGoodRevisionAndDestroy(t *testing.T) {
+	target := newIntegrationTarget(t, 9)
+	writeIntegrationRevision(t, target, 9)
+
+	ownManifestPath := path.Join(target.manifestsPath, "kube-apiserver-startup-monitor.yaml")
+	if err := os.WriteFile(ownManifestPath, []byte("placeholder"), 0644); err != nil {
+		t.Fatalf("failed to write the monitor's own manifest: %v", err)
+	}
+
+	if err := target.createLastKnowGoodRevisionAndDestroy(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	lastKnownGoodPath := target.lastKnownGoodManifestDstPath()
+	resolved, err := os.Readlink(lastKnownGoodPath)
+	if err != nil {
+		t.Fatalf("expected %q to be a symlink: %v", lastKnownGoodPath, err)
+	}
+	if resolved != target.targetManifestPathFor(9) {
+		t.Errorf("unexpected symlink target %q, expected %q", resolved, target.targetManifestPathFor(9))
+	}
+	if _, err := os.Stat(lastKnownGoodPath); err != nil {
+		t.Errorf("expected the symlink to resolve to a readable file, got: %v", err)
+	}
+
+	if _, err := os.Stat(ownManifestPath); !os.IsNotExist(err) {
+		t.Errorf("expected the monitor's own manifest to have been removed, stat returned: %v", err)
+	}
+}
+
+// TestIntegrationFallbackToPreviousRevision exercises fallbackToPreviousRevision against a real
+// temp filesystem: the root manifest is really removed and rewritten from the previous
+// revision, verifying the on-disk end state a mock can only assert was asked for.
+func TestIntegrationFallbackToPreviousRevision(t *testing.T) {
+	target := newIntegrationTarget(t, 10)
+	writeIntegrationRevision(t, target, 9)
+	writeIntegrationRevision(t, target, 10)
+
+	rootManifestPath := target.rootManifestPath()
+	if err := os.WriteFile(rootManifestPath, []byte(integrationPodManifest(10)), 0644); err != nil {
+		t.Fatalf("failed to write the root manifest: %v", err)
+	}
+
+	if err := target.fallbackToPreviousRevision(""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	rolledBackPod, err := target.readTargetPod(rootManifestPath)
+	if err != nil {
+		t.Fatalf("failed to read the rolled back root manifest: %v", err)
+	}
+	if revision, err := revisionOfPod(rolledBackPod); err != nil {
+		t.Fatalf("failed to extract the rolled back revision: %v", err)
+	} else if revision != 9 {
+		t.Errorf("unexpected rolled back revision %d, expected 9", revision)
+	}
+
+	lastKnownGoodPath := target.lastKnownGoodManifestDstPath()
+	if _, err := os.Lstat(lastKnownGoodPath); err != nil {
+		t.Fatalf("expected a last known good symlink at %q: %v", lastKnownGoodPath, err)
+	}
+}
+
+// TestIntegrationFallbackToPreviousRevisionWithDeterministicUID exercises WithUIDProvider:
+// with a fixed UID substituted for uuid.NewUUID, the fallback pod's exact output manifest can
+// be asserted byte-for-byte instead of only checking individual fields.
+func TestIntegrationFallbackToPreviousRevisionWithDeterministicUID(t *testing.T) {
+	target := newIntegrationTarget(t, 10)
+	target.WithUIDProvider(func() types.UID { return "fixed-uid" })
+	writeIntegrationRevision(t, target, 9)
+	writeIntegrationRevision(t, target, 10)
+
+	rootManifestPath := target.rootManifestPath()
+	if err := os.WriteFile(rootManifestPath, []byte(integrationPodManifest(10)), 0644); err != nil {
+		t.Fatalf("failed to write the root manifest: %v", err)
+	}
+
+	if err := target.fallbackToPreviousRevision(""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	rolledBackPod, err := target.readTargetPod(rootManifestPath)
+	if err != nil {
+		t.Fatalf("failed to read the rolled back root manifest: %v", err)
+	}
+	if rolledBackPod.UID != "fixed-uid" {
+		t.Errorf("unexpected UID %q, expected %q", rolledBackPod.UID, "fixed-uid")
+	}
+}
+
+// TestIntegrationFallbackToPreviousRevisionWithCustomAnnotationPrefix exercises
+// WithAnnotationPrefix together with the failure reason passed into fallbackToPreviousRevision,
+// asserting the fallback pod's manifest carries the full set of context annotations under the
+// overridden domain, so an operator can reconstruct what happened from the pod manifest alone.
+func TestIntegrationFallbackToPreviousRevisionWithCustomAnnotationPrefix(t *testing.T) {
+	target := newIntegrationTarget(t, 10)
+	target.WithAnnotationPrefix("example.com/startup-monitor")
+	writeIntegrationRevision(t, target, 9)
+	writeIntegrationRevision(t, target, 10)
+
+	rootManifestPath := target.rootManifestPath()
+	if err := os.WriteFile(rootManifestPath, []byte(integrationPodManifest(10)), 0644); err != nil {
+		t.Fatalf("failed to write the root manifest: %v", err)
+	}
+
+	if err := target.fallbackToPreviousRevision("target timed out"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	rolledBackPod, err := target.readTargetPod(rootManifestPath)
+	if err != nil {
+		t.Fatalf("failed to read the rolled back root manifest: %v", err)
+	}
+
+	expected := map[string]string{
+		"example.com/startup-monitor/fallback-for-revision": "10",
+		"example.com/startup-monitor/fallback-probe-count":  "0",
+		"example.com/startup-monitor/fallback-reason":       "target timed out",
+		"example.com/startup-monitor/monitor-version":       "unknown",
+	}
+	for key, want := range expected {
+		if got := rolledBackPod.Annotations[key]; got != want {
+			t.Errorf("unexpected annotation %q: got %q, expected %q", key, got, want)
+		}
+	}
+	if _, ok := rolledBackPod.Annotations["example.com/startup-monitor/fallback-timestamp"]; !ok {
+		t.Errorf("expected a fallback-timestamp annotation")
+	}
+	if _, ok := rolledBackPod.Annotations["startup-monitor.static-pods.openshift.io/fallback-for-revision"]; ok {
+		t.Errorf("expected the default annotation prefix not to be used once overridden")
+	}
+}
+
+// TestIntegrationFallbackToPinnedRevision exercises WithPinnedFallbackRevision against a real
+// temp filesystem: even though the last-known-good/N-1 heuristic would land on revision 9, the
+// pinned override forces the fallback onto revision 8 instead.
+func TestIntegrationFallbackToPinnedRevision(t *testing.T) {
+	target := newIntegrationTarget(t, 10)
+	target.WithPinnedFallbackRevision(8)
+	writeIntegrationRevision(t, target, 8)
+	writeIntegrationRevision(t, target, 9)
+	writeIntegrationRevision(t, target, 10)
+
+	rootManifestPath := target.rootManifestPath()
+	if err := os.WriteFile(rootManifestPath, []byte(integrationPodManifest(10)), 0644); err != nil {
+		t.Fatalf("failed to write the root manifest: %v", err)
+	}
+
+	if err := target.fallbackToPreviousRevision(""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	rolledBackPod, err := target.readTargetPod(rootManifestPath)
+	if err != nil {
+		t.Fatalf("failed to read the rolled back root manifest: %v", err)
+	}
+	if got := rolledBackPod.Labels["revision"]; got != "8" {
+		t.Errorf("unexpected revision %q, expected the pinned revision 8", got)
+	}
+}
+
+// TestIntegrationFallbackToPinnedRevisionMissingManifest exercises WithPinnedFallbackRevision
+// against a real temp filesystem when the pinned revision has no manifest on disk: the fallback
+// must fail loudly instead of silently falling back to the usual heuristic.
+func TestIntegrationFallbackToPinnedRevisionMissingManifest(t *testing.T) {
+	target := newIntegrationTarget(t, 10)
+	target.WithPinnedFallbackRevision(8)
+	writeIntegrationRevision(t, target, 9)
+	writeIntegrationRevision(t, target, 10)
+
+	rootManifestPath := target.rootManifestPath()
+	if err := os.WriteFile(rootManifestPath, []byte(integrationPodManifest(10)), 0644); err != nil {
+		t.Fatalf("failed to write the root manifest: %v", err)
+	}
+
+	if err := target.fallbackToPreviousRevision(""); err == nil {
+		t.Fatalf("expected an error, got nil")
+	}
+}
+
+// TestIntegrationSleepModeKeepsOwnManifestWhenHealthy exercises WithSleepMode against a real
+// temp filesystem: once the target is observed healthy, the last known good revision is still
+// recorded as usual, but the monitor's own manifest is left in place instead of being removed.
+func TestIntegrationSleepModeKeepsOwnManifestWhenHealthy(t *testing.T) {
+	target := newIntegrationTarget(t, 9)
+	target.WithSleepMode()
+	writeIntegrationRevision(t, target, 9)
+
+	rootManifestPath := target.rootManifestPath()
+	if err := os.WriteFile(rootManifestPath, []byte(integrationPodManifest(9)), 0644); err != nil {
+		t.Fatalf("failed to write the root manifest: %v", err)
+	}
+	ownManifestPath := path.Join(target.manifestsPath, "kube-apiserver-startup-monitor.yaml")
+	if err := os.WriteFile(ownManifestPath, []byte("placeholder"), 0644); err != nil {
+		t.Fatalf("failed to write the monitor's own manifest: %v", err)
+	}
+
+	target.probeCache.set(true)
+	if err := target.sync(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if status := target.GetStatus(); status.Phase != PhaseHealthy {
+		t.Errorf("unexpected phase %q, expected %q", status.Phase, PhaseHealthy)
+	}
+	if _, err := os.Stat(ownManifestPath); err != nil {
+		t.Errorf("expected the monitor's own manifest to still exist while asleep, stat returned: %v", err)
+	}
+	lastKnownGoodPath := target.lastKnownGoodManifestDstPath()
+	if _, err := os.Lstat(lastKnownGoodPath); err != nil {
+		t.Fatalf("expected a last known good symlink at %q: %v", lastKnownGoodPath, err)
+	}
+}
+
+// TestIntegrationHealthyVerdictMarker exercises the verdict marker written into a revision's
+// resource directory once the target is observed healthy on a real temp filesystem.
+func TestIntegrationHealthyVerdictMarker(t *testing.T) {
+	target := newIntegrationTarget(t, 9)
+	writeIntegrationRevision(t, target, 9)
+
+	rootManifestPath := target.rootManifestPath()
+	if err := os.WriteFile(rootManifestPath, []byte(integrationPodManifest(9)), 0644); err != nil {
+		t.Fatalf("failed to write the root manifest: %v", err)
+	}
+	ownManifestPath := path.Join(target.manifestsPath, "kube-apiserver-startup-monitor.yaml")
+	if err := os.WriteFile(ownManifestPath, []byte("placeholder"), 0644); err != nil {
+		t.Fatalf("failed to write the monitor's own manifest: %v", err)
+	}
+
+	target.probeCache.set(true)
+	if err := target.sync(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	verdict, err := os.ReadFile(target.verdictPathFor(9))
+	if err != nil {
+		t.Fatalf("expected a healthy verdict marker for revision 9: %v", err)
+	}
+	if !strings.HasPrefix(string(verdict), "healthy-at: ") {
+		t.Errorf("unexpected verdict marker contents %q", verdict)
+	}
+}
+
+// TestIntegrationFailedVerdictMarker exercises the verdict marker written into a revision's
+// resource directory once the monitor falls back away from it on a real temp filesystem.
+func TestIntegrationFailedVerdictMarker(t *testing.T) {
+	target := newIntegrationTarget(t, 10)
+	writeIntegrationRevision(t, target, 9)
+	writeIntegrationRevision(t, target, 10)
+
+	rootManifestPath := target.rootManifestPath()
+	if err := os.WriteFile(rootManifestPath, []byte(integrationPodManifest(10)), 0644); err != nil {
+		t.Fatalf("failed to write the root manifest: %v", err)
+	}
+
+	if err := target.performFallback(context.Background(), ReasonTimeout); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	verdict, err := os.ReadFile(target.verdictPathFor(10))
+	if err != nil {
+		t.Fatalf("expected a failed verdict marker for revision 10: %v", err)
+	}
+	if !strings.Contains(string(verdict), "reason: Timeout") {
+		t.Errorf("unexpected verdict marker contents %q", verdict)
+	}
+}
+
+// TestIntegrationFallbackDeniesFallenBackFromRevision exercises the fallback-attempts deny-list
+// against a real temp filesystem: once performFallback falls back away from a revision, that
+// revision is persisted as a known-bad fallback candidate, so findPreviousRevision skips it even
+// after the monitor is restarted (represented here by loading a fresh blacklist from disk).
+func TestIntegrationFallbackDeniesFallenBackFromRevision(t *testing.T) {
+	target := newIntegrationTarget(t, 10)
+	writeIntegrationRevision(t, target, 9)
+	writeIntegrationRevision(t, target, 10)
+
+	rootManifestPath := target.rootManifestPath()
+	if err := os.WriteFile(rootManifestPath, []byte(integrationPodManifest(10)), 0644); err != nil {
+		t.Fatalf("failed to write the root manifest: %v", err)
+	}
+
+	if err := target.performFallback(context.Background(), ReasonTimeout); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	restarted := New(nil)
+	restarted.io = realFS{}
+	restarted.revision = 11
+	restarted.targetName = target.targetName
+	restarted.manifestsPath = target.manifestsPath
+	restarted.staticPodResourcesPath = target.staticPodResourcesPath
+	if err := restarted.loadFallbackAttemptsIntoBlacklist(); err != nil {
+		t.Fatalf("unexpected error loading the fallback-attempts ledger: %v", err)
+	}
+	if !restarted.blacklistedRevisions[10] {
+		t.Errorf("expected revision 10 to be persisted as a known-bad fallback candidate")
+	}
+}
+
+// TestIntegrationKnownGoodSnapshotArchivesDependentFiles exercises archiveKnownGoodSnapshot
+// against a real temp filesystem: once the target is observed healthy, the manifest and the
+// dependent files declared by dependentFilesAnnotation are archived, independently of layout.
+func TestIntegrationKnownGoodSnapshotArchivesDependentFiles(t *testing.T) {
+	target := newIntegrationTarget(t, 9)
+	target.WithKnownGoodSnapshotRetention(1)
+	writeIntegrationRevision(t, target, 9)
+
+	dependentFile := path.Join(t.TempDir(), "kubeconfig")
+	if err := os.WriteFile(dependentFile, []byte("cert-contents"), 0644); err != nil {
+		t.Fatalf("failed to write the dependent file: %v", err)
+	}
+
+	manifestWithDependentFile := integrationPodManifestWithDependentFile(9, dependentFile)
+	rootManifestPath := target.rootManifestPath()
+	if err := os.WriteFile(rootManifestPath, []byte(manifestWithDependentFile), 0644); err != nil {
+		t.Fatalf("failed to write the root manifest: %v", err)
+	}
+	// archiveKnownGoodSnapshot reads from the revisioned manifest, so it needs the annotation too.
+	if err := os.WriteFile(target.targetManifestPathFor(9), []byte(manifestWithDependentFile), 0644); err != nil {
+		t.Fatalf("failed to write the revisioned manifest: %v", err)
+	}
+	ownManifestPath := path.Join(target.manifestsPath, "kube-apiserver-startup-monitor.yaml")
+	if err := os.WriteFile(ownManifestPath, []byte("placeholder"), 0644); err != nil {
+		t.Fatalf("failed to write the monitor's own manifest: %v", err)
+	}
+
+	target.probeCache.set(true)
+	if err := target.sync(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := os.Stat(target.knownGoodSnapshotManifestPathFor(9)); err != nil {
+		t.Errorf("expected the manifest to be archived: %v", err)
+	}
+	archived, err := os.ReadFile(target.knownGoodSnapshotFilePathFor(9, 0))
+	if err != nil {
+		t.Fatalf("expected the dependent file to be archived: %v", err)
+	}
+	if string(archived) != "cert-contents" {
+		t.Errorf("unexpected archived dependent file contents %q", archived)
+	}
+}
+
+// TestIntegrationKnownGoodSnapshotPrunesOldestOnceRetentionExceeded exercises
+// recordAndPruneKnownGoodSnapshotHistory against a real temp filesystem: once more revisions
+// than knownGoodSnapshotRetention have been archived, the oldest one is removed.
+func TestIntegrationKnownGoodSnapshotPrunesOldestOnceRetentionExceeded(t *testing.T) {
+	target := newIntegrationTarget(t, 9)
+	target.WithKnownGoodSnapshotRetention(1)
+	writeIntegrationRevision(t, target, 9)
+
+	rootManifestPath := target.rootManifestPath()
+	if err := os.WriteFile(rootManifestPath, []byte(integrationPodManifest(9)), 0644); err != nil {
+		t.Fatalf("failed to write the root manifest: %v", err)
+	}
+	ownManifestPath := path.Join(target.manifestsPath, "kube-apiserver-startup-monitor.yaml")
+	if err := os.WriteFile(ownManifestPath, []byte("placeholder"), 0644); err != nil {
+		t.Fatalf("failed to write the monitor's own manifest: %v", err)
+	}
+	target.probeCache.set(true)
+	if err := target.sync(context.Background()); err != nil {
+		t.Fatalf("unexpected error archiving revision 9: %v", err)
+	}
+	if _, err := os.Stat(target.knownGoodSnapshotManifestPathFor(9)); err != nil {
+		t.Fatalf("expected revision 9 to be archived: %v", err)
+	}
+
+	target.revision = 10
+	writeIntegrationRevision(t, target, 10)
+	if err := os.WriteFile(rootManifestPath, []byte(integrationPodManifest(10)), 0644); err != nil {
+		t.Fatalf("failed to write the revision 10 root manifest: %v", err)
+	}
+	if err := os.WriteFile(ownManifestPath, []byte("placeholder"), 0644); err != nil {
+		t.Fatalf("failed to rewrite the monitor's own manifest: %v", err)
+	}
+	if err := target.sync(context.Background()); err != nil {
+		t.Fatalf("unexpected error archiving revision 10: %v", err)
+	}
+
+	if _, err := os.Stat(target.knownGoodSnapshotManifestPathFor(10)); err != nil {
+		t.Errorf("expected revision 10 to be archived: %v", err)
+	}
+	if _, err := os.Stat(target.knownGoodSnapshotManifestPathFor(9)); !os.IsNotExist(err) {
+		t.Errorf("expected revision 9's archive to have been pruned, stat returned: %v", err)
+	}
+}
+
+// TestIntegrationFallbackRepairsMissingDependentFile exercises WithDependentFileVerification
+// against a real temp filesystem: when the previous revision's dependent file has gone missing
+// on disk (e.g. an installer failure), but a known-good snapshot archive for that revision
+// still has a copy, fallbackToPreviousRevision restores it before rewriting the root manifest.
+func TestIntegrationFallbackRepairsMissingDependentFile(t *testing.T) {
+	target := newIntegrationTarget(t, 10)
+	target.WithKnownGoodSnapshotRetention(1)
+	target.WithDependentFileVerification()
+
+	dependentFile := path.Join(t.TempDir(), "kubeconfig")
+	if err := os.WriteFile(dependentFile, []byte("cert-contents"), 0644); err != nil {
+		t.Fatalf("failed to write the dependent file: %v", err)
+	}
+
+	manifestWithDependentFile := integrationPodManifestWithDependentFile(9, dependentFile)
+	if err := os.MkdirAll(path.Dir(target.targetManifestPathFor(9)), 0755); err != nil {
+		t.Fatalf("failed to create the revision 9 directory: %v", err)
+	}
+	if err := os.WriteFile(target.targetManifestPathFor(9), []byte(manifestWithDependentFile), 0644); err != nil {
+		t.Fatalf("failed to write the revision 9 manifest: %v", err)
+	}
+	writeIntegrationRevision(t, target, 10)
+
+	if err := target.archiveKnownGoodSnapshot(9); err != nil {
+		t.Fatalf("failed to archive revision 9: %v", err)
+	}
+
+	if err := os.Remove(dependentFile); err != nil {
+		t.Fatalf("failed to remove the dependent file: %v", err)
+	}
+
+	rootManifestPath := target.rootManifestPath()
+	if err := os.WriteFile(rootManifestPath, []byte(integrationPodManifest(10)), 0644); err != nil {
+		t.Fatalf("failed to write the root manifest: %v", err)
+	}
+
+	if err := target.fallbackToPreviousRevision(""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	repaired, err := os.ReadFile(dependentFile)
+	if err != nil {
+		t.Fatalf("expected the dependent file to have been repaired: %v", err)
+	}
+	if string(repaired) != "cert-contents" {
+		t.Errorf("unexpected repaired dependent file contents %q", repaired)
+	}
+}
+
+// TestIntegrationObservationModeSkipsFallback exercises WithObservationMode against a real
+// temp filesystem: performFallback still runs its checks and writes the failed-verdict marker,
+// but leaves the root manifest untouched instead of really rolling back.
+func TestIntegrationObservationModeSkipsFallback(t *testing.T) {
+	target := newIntegrationTarget(t, 10)
+	target.WithObservationMode(1)
+	writeIntegrationRevision(t, target, 9)
+	writeIntegrationRevision(t, target, 10)
+
+	rootManifestPath := target.rootManifestPath()
+	if err := os.WriteFile(rootManifestPath, []byte(integrationPodManifest(10)), 0644); err != nil {
+		t.Fatalf("failed to write the root manifest: %v", err)
+	}
+
+	if err := target.performFallback(context.Background(), ReasonTimeout); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if status := target.GetStatus(); status.Phase != PhaseDegraded {
+		t.Errorf("unexpected phase %q, expected %q", status.Phase, PhaseDegraded)
+	}
+	rolledBackPod, err := target.readTargetPod(rootManifestPath)
+	if err != nil {
+		t.Fatalf("failed to read the root manifest: %v", err)
+	}
+	if revision, err := revisionOfPod(rolledBackPod); err != nil {
+		t.Fatalf("failed to extract the revision: %v", err)
+	} else if revision != 10 {
+		t.Errorf("unexpected revision %d, expected the root manifest to be left untouched at 10", revision)
+	}
+
+	verdict, err := os.ReadFile(target.verdictPathFor(10))
+	if err != nil {
+		t.Fatalf("expected a failed verdict marker for revision 10 even in observation mode: %v", err)
+	}
+	if !strings.Contains(string(verdict), "reason: Timeout") {
+		t.Errorf("unexpected verdict marker contents %q", verdict)
+	}
+}
+
+// TestIntegrationObservationModeGraduatesAfterConfiguredRevisions exercises the durable
+// observation mode ledger against a real temp filesystem: once as many distinct revisions as
+// WithObservationMode allows have been recorded, a later one falls back for real.
+func TestIntegrationObservationModeGraduatesAfterConfiguredRevisions(t *testing.T) {
+	target := newIntegrationTarget(t, 10)
+	target.WithObservationMode(1)
+	writeIntegrationRevision(t, target, 9)
+	writeIntegrationRevision(t, target, 10)
+	writeIntegrationRevision(t, target, 11)
+
+	rootManifestPath := target.rootManifestPath()
+	if err := os.WriteFile(rootManifestPath, []byte(integrationPodManifest(10)), 0644); err != nil {
+		t.Fatalf("failed to write the revision 10 root manifest: %v", err)
+	}
+	if err := target.performFallback(context.Background(), ReasonTimeout); err != nil {
+		t.Fatalf("unexpected error observing revision 10: %v", err)
+	}
+	if revision, err := revisionOfPod(mustReadIntegrationTargetPod(t, target, rootManifestPath)); err != nil || revision != 10 {
+		t.Fatalf("expected revision 10 to still be observed rather than rolled back, got revision %d, err %v", revision, err)
+	}
+
+	// a later revision, guarded by a fresh monitor process as production deploys one per
+	// revision, has already exhausted the one observed revision recorded above.
+	target.revision = 11
+	if err := os.WriteFile(rootManifestPath, []byte(integrationPodManifest(11)), 0644); err != nil {
+		t.Fatalf("failed to write the revision 11 root manifest: %v", err)
+	}
+	if err := target.performFallback(context.Background(), ReasonTimeout); err != nil {
+		t.Fatalf("unexpected error falling back from revision 11: %v", err)
+	}
+
+	if revision, err := revisionOfPod(mustReadIntegrationTargetPod(t, target, rootManifestPath)); err != nil {
+		t.Fatalf("failed to extract the rolled back revision: %v", err)
+	} else if revision != 10 {
+		t.Errorf("unexpected revision %d, expected revision 11 to have really rolled back to 10", revision)
+	}
+}
+
+// mustReadIntegrationTargetPod reads and parses the pod manifest at path, failing the test on
+// any error.
+func mustReadIntegrationTargetPod(t *testing.T, target *StartupMonitor, path string) *corev1.Pod {
+	t.Helper()
+	pod, err := target.readTargetPod(path)
+	if err != nil {
+		t.Fatalf("failed to read the pod manifest at %q: %v", path, err)
+	}
+	return pod
+}
+
+// TestIntegrationSnapshotCurrentManifestForSelfManagedLayout exercises
+// snapshotCurrentManifestIfNeeded against a real temp filesystem: with kubeadmLayout, which has
+// no installer preserving revisioned copies, the monitor snapshots the manifest and its host
+// path volumes itself.
+func TestIntegrationSnapshotCurrentManifestForSelfManagedLayout(t *testing.T) {
+	target := newIntegrationTarget(t, 5)
+	target.WithLayout(kubeadmLayout{})
+
+	hostPathFile := path.Join(t.TempDir(), "kubeconfig")
+	if err := os.WriteFile(hostPathFile, []byte("host-path-contents"), 0644); err != nil {
+		t.Fatalf("failed to write the host path file: %v", err)
+	}
+
+	rootManifestPath := target.rootManifestPath()
+	if err := os.WriteFile(rootManifestPath, []byte(integrationPodManifestWithHostPath(5, "kubeconfig", hostPathFile)), 0644); err != nil {
+		t.Fatalf("failed to write the root manifest: %v", err)
+	}
+
+	if err := target.sync(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := os.Stat(target.targetManifestPathFor(5)); err != nil {
+		t.Errorf("expected the manifest to be snapshotted at %q: %v", target.targetManifestPathFor(5), err)
+	}
+	snapshotContents, err := os.ReadFile(target.hostPathSnapshotPathFor(5, "kubeconfig"))
+	if err != nil {
+		t.Fatalf("expected the host path volume to be snapshotted: %v", err)
+	}
+	if string(snapshotContents) != "host-path-contents" {
+		t.Errorf("unexpected host path snapshot contents %q", snapshotContents)
+	}
+}
+
+// TestIntegrationFallbackRestoresHostPathVolumeForSelfManagedLayout exercises
+// fallbackToPreviousRevision against a real temp filesystem: with kubeadmLayout, falling back
+// restores not just the manifest but the host path files a healthy earlier revision snapshotted.
+func TestIntegrationFallbackRestoresHostPathVolumeForSelfManagedLayout(t *testing.T) {
+	target := newIntegrationTarget(t, 9)
+	target.WithLayout(kubeadmLayout{})
+
+	hostPathFile := path.Join(t.TempDir(), "kubeconfig")
+	if err := os.WriteFile(hostPathFile, []byte("revision-9-contents"), 0644); err != nil {
+		t.Fatalf("failed to write the host path file: %v", err)
+	}
+
+	rootManifestPath := target.rootManifestPath()
+	if err := os.WriteFile(rootManifestPath, []byte(integrationPodManifestWithHostPath(9, "kubeconfig", hostPathFile)), 0644); err != nil {
+		t.Fatalf("failed to write the root manifest: %v", err)
+	}
+	target.probeCache.set(true)
+	if err := target.sync(context.Background()); err != nil {
+		t.Fatalf("unexpected error recording revision 9 as healthy: %v", err)
+	}
+
+	// revision 10 rolls out, mutating the host path file in place, as a kubeadm upgrade would.
+	target.revision = 10
+	if err := os.WriteFile(hostPathFile, []byte("revision-10-contents"), 0644); err != nil {
+		t.Fatalf("failed to overwrite the host path file: %v", err)
+	}
+	if err := os.WriteFile(rootManifestPath, []byte(integrationPodManifestWithHostPath(10, "kubeconfig", hostPathFile)), 0644); err != nil {
+		t.Fatalf("failed to write the revision 10 root manifest: %v", err)
+	}
+
+	if err := target.fallbackToPreviousRevision(""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	restoredContents, err := os.ReadFile(hostPathFile)
+	if err != nil {
+		t.Fatalf("failed to read the host path file after fallback: %v", err)
+	}
+	if string(restoredContents) != "revision-9-contents" {
+		t.Errorf("unexpected host path contents after fallback %q, expected the revision 9 contents to be restored", restoredContents)
+	}
+}
+
+// TestIntegrationLastKnownGoodHistoryRotates exercises WithLastKnownGoodHistorySize against a
+// real temp filesystem: as successive revisions are proven healthy, older last known good
+// pointers are shifted into higher-numbered slots instead of being discarded, up to the
+// configured history size.
+func TestIntegrationLastKnownGoodHistoryRotates(t *testing.T) {
+	target := newIntegrationTarget(t, 7)
+	target.WithLastKnownGoodHistorySize(2)
+	writeIntegrationRevision(t, target, 7)
+	writeIntegrationRevision(t, target, 8)
+
+	if err := target.createLastKnowGoodRevisionFor(7, true); err != nil {
+		t.Fatalf("unexpected error recording revision 7: %v", err)
+	}
+	if err := target.createLastKnowGoodRevisionFor(8, true); err != nil {
+		t.Fatalf("unexpected error recording revision 8: %v", err)
+	}
+
+	currentPod, err := target.readTargetPod(target.lastKnownGoodManifestDstPath())
+	if err != nil {
+		t.Fatalf("failed to read the current last known good pointer: %v", err)
+	}
+	if revision, err := revisionOfPod(currentPod); err != nil || revision != 8 {
+		t.Errorf("unexpected current last known good revision %d (err %v), expected 8", revision, err)
+	}
+
+	historicalPod, err := target.readTargetPod(target.lastKnownGoodManifestDstPathAt(1))
+	if err != nil {
+		t.Fatalf("failed to read the historical last known good pointer: %v", err)
+	}
+	if revision, err := revisionOfPod(historicalPod); err != nil || revision != 7 {
+		t.Errorf("unexpected historical last known good revision %d (err %v), expected 7", revision, err)
+	}
+}
+
+// TestIntegrationProtectRevisionFollowsLastKnownGood exercises the protection marker written by
+// createLastKnowGoodRevisionFor: it should always be held by the current last known good
+// revision, and released as soon as a different revision takes its place, so a pruner consulting
+// IsRevisionProtected never deletes a fallback target out from under a future fallback.
+func TestIntegrationProtectRevisionFollowsLastKnownGood(t *testing.T) {
+	target := newIntegrationTarget(t, 7)
+	writeIntegrationRevision(t, target, 7)
+	writeIntegrationRevision(t, target, 8)
+
+	if err := target.createLastKnowGoodRevisionFor(7, true); err != nil {
+		t.Fatalf("unexpected error recording revision 7: %v", err)
+	}
+	if protected, err := target.fileExists(target.protectedRevisionMarkerPathFor(7)); err != nil || !protected {
+		t.Fatalf("expected revision 7 to be protected (err %v)", err)
+	}
+
+	if err := target.createLastKnowGoodRevisionFor(8, true); err != nil {
+		t.Fatalf("unexpected error recording revision 8: %v", err)
+	}
+	if protected, err := target.fileExists(target.protectedRevisionMarkerPathFor(8)); err != nil || !protected {
+		t.Fatalf("expected revision 8 to be protected (err %v)", err)
+	}
+	if protected, err := target.fileExists(target.protectedRevisionMarkerPathFor(7)); err != nil || protected {
+		t.Fatalf("expected revision 7 to no longer be protected (err %v)", err)
+	}
+
+	info := TargetInfo{
+		TargetName:             target.targetName,
+		StaticPodResourcesPath: target.staticPodResourcesPath,
+		ManifestsPath:          target.manifestsPath,
+	}
+	if protected, err := IsRevisionProtected(info, 8); err != nil || !protected {
+		t.Errorf("expected IsRevisionProtected to report revision 8 as protected (err %v)", err)
+	}
+	if protected, err := IsRevisionProtected(info, 7); err != nil || protected {
+		t.Errorf("expected IsRevisionProtected to report revision 7 as no longer protected (err %v)", err)
+	}
+}
+
+// TestIntegrationFallbackPromotesHistoricalLastKnownGood exercises fallbackToPreviousRevision
+// with WithLastKnownGoodHistorySize set: when the current last known good pointer is missing, a
+// historical pointer is promoted and used instead of falling back to scanning the resource
+// directory for any older revision.
+func TestIntegrationFallbackPromotesHistoricalLastKnownGood(t *testing.T) {
+	target := newIntegrationTarget(t, 10)
+	target.WithLastKnownGoodHistorySize(2)
+	writeIntegrationRevision(t, target, 8)
+	writeIntegrationRevision(t, target, 9)
+	writeIntegrationRevision(t, target, 10)
+
+	if err := target.createLastKnowGoodRevisionFor(8, true); err != nil {
+		t.Fatalf("unexpected error recording revision 8: %v", err)
+	}
+	if err := target.createLastKnowGoodRevisionFor(9, true); err != nil {
+		t.Fatalf("unexpected error recording revision 9: %v", err)
+	}
+
+	// simulate the current pointer having gone missing, leaving only the historical one behind.
+	if err := os.Remove(target.lastKnownGoodManifestDstPath()); err != nil {
+		t.Fatalf("failed to remove the current last known good pointer: %v", err)
+	}
+
+	rootManifestPath := target.rootManifestPath()
+	if err := os.WriteFile(rootManifestPath, []byte(integrationPodManifest(10)), 0644); err != nil {
+		t.Fatalf("failed to write the root manifest: %v", err)
+	}
+
+	if err := target.fallbackToPreviousRevision(""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	rolledBackPod, err := target.readTargetPod(rootManifestPath)
+	if err != nil {
+		t.Fatalf("failed to read the rolled back root manifest: %v", err)
+	}
+	if revision, err := revisionOfPod(rolledBackPod); err != nil {
+		t.Fatalf("failed to extract the rolled back revision: %v", err)
+	} else if revision != 8 {
+		t.Errorf("unexpected rolled back revision %d, expected 8", revision)
+	}
+}
+
+// TestIntegrationSymlinkOrCopyDanglingTarget verifies that a real dangling symlink, i.e. one
+// whose target has since been removed, is surfaced as a read failure rather than silently
+// treated as success -- a class of bug fakeIO's in-memory ReadFile/Symlink stand-ins can't
+// reproduce, since they never model the two calls actually touching the same backing file.
+func TestIntegrationSymlinkOrCopyDanglingTarget(t *testing.T) {
+	target := newIntegrationTarget(t, 9)
+	writeIntegrationRevision(t, target, 9)
+
+	revisionedPath := target.targetManifestPathFor(9)
+	linkPath := path.Join(target.staticPodResourcesPath, "kube-apiserver-last-known-good")
+	if err := target.symlinkOrCopy(revisionedPath, linkPath); err != nil {
+		t.Fatalf("unexpected error creating the symlink: %v", err)
+	}
+
+	if err := os.Remove(revisionedPath); err != nil {
+		t.Fatalf("failed to remove the revisioned manifest: %v", err)
+	}
+
+	if _, err := target.readTargetPod(linkPath); err == nil {
+		t.Error("expected reading through a dangling symlink to fail, got nil error")
+	}
+}
+
+// TestIntegrationSyncAcquiresAndReleasesInstallerLock verifies that sync, when configured with
+// WithInstallerLockFilePath, holds the lock for the duration of the sync iteration and releases
+// it before returning, leaving it free for a concurrent installer to acquire.
+func TestIntegrationSyncAcquiresAndReleasesInstallerLock(t *testing.T) {
+	target := newIntegrationTarget(t, 9)
+	target.WithSleepMode()
+	writeIntegrationRevision(t, target, 9)
+
+	rootManifestPath := target.rootManifestPath()
+	if err := os.WriteFile(rootManifestPath, []byte(integrationPodManifest(9)), 0644); err != nil {
+		t.Fatalf("failed to write the root manifest: %v", err)
+	}
+
+	lockPath := path.Join(t.TempDir(), "installer.lock")
+	target.WithInstallerLockFilePath(lockPath)
+
+	target.probeCache.set(true)
+	if err := target.sync(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	installer := NewFLock(lockPath)
+	if err := installer.TryLock(time.Second); err != nil {
+		t.Fatalf("expected the installer coordination lock to be free once sync returns, got: %v", err)
+	}
+	if err := installer.Unlock(); err != nil {
+		t.Errorf("failed to release the lock: %v", err)
+	}
+}
+
+// TestIntegrationSyncFailsWhenInstallerLockIsHeld verifies that sync surfaces a
+// SyncErrorCategoryInstallerLock error, rather than touching the root manifest, when the
+// installer coordination lock is already held by someone else and its timeout elapses.
+func TestIntegrationSyncFailsWhenInstallerLockIsHeld(t *testing.T) {
+	target := newIntegrationTarget(t, 9)
+	target.WithSleepMode()
+	writeIntegrationRevision(t, target, 9)
+
+	rootManifestPath := target.rootManifestPath()
+	if err := os.WriteFile(rootManifestPath, []byte(integrationPodManifest(9)), 0644); err != nil {
+		t.Fatalf("failed to write the root manifest: %v", err)
+	}
+
+	lockPath := path.Join(t.TempDir(), "installer.lock")
+	installer := NewFLock(lockPath)
+	if err := installer.TryLock(time.Second); err != nil {
+		t.Fatalf("failed to acquire the lock: %v", err)
+	}
+	defer func() {
+		if err := installer.Unlock(); err != nil {
+			t.Errorf("failed to release the lock: %v", err)
+		}
+	}()
+
+	target.WithInstallerLockFilePath(lockPath).WithInstallerLockTimeout(500 * time.Millisecond)
+
+	target.probeCache.set(true)
+	err := target.sync(context.Background())
+	if err == nil {
+		t.Fatal("expected an error while the installer coordination lock is held, got nil")
+	}
+	if category := syncErrorCategoryOf(err); category != SyncErrorCategoryInstallerLock {
+		t.Errorf("unexpected error category %q, expected %q", category, SyncErrorCategoryInstallerLock)
+	}
+}
+
+// TestIntegrationSyncPausesCountdownWhileInstallerLockContended verifies that time spent
+// contending for the installer coordination lock doesn't count against the fallback deadline: an
+// old monitorTimeStamp, one that would otherwise already be past the timeout, is pushed out once
+// sync has to wait out real contention on the lock to acquire it.
+func TestIntegrationSyncPausesCountdownWhileInstallerLockContended(t *testing.T) {
+	target := newIntegrationTarget(t, 9)
+	target.WithSleepMode()
+	writeIntegrationRevision(t, target, 9)
+
+	rootManifestPath := target.rootManifestPath()
+	if err := os.WriteFile(rootManifestPath, []byte(integrationPodManifest(9)), 0644); err != nil {
+		t.Fatalf("failed to write the root manifest: %v", err)
+	}
+
+	lockPath := path.Join(t.TempDir(), "installer.lock")
+	installer := NewFLock(lockPath)
+	if err := installer.TryLock(time.Second); err != nil {
+		t.Fatalf("failed to acquire the lock: %v", err)
+	}
+	go func() {
+		time.Sleep(500 * time.Millisecond)
+		if err := installer.Unlock(); err != nil {
+			t.Errorf("failed to release the lock: %v", err)
+		}
+	}()
+
+	target.WithInstallerLockFilePath(lockPath).WithInstallerLockTimeout(5 * time.Second)
+	target.timeout = time.Minute
+	target.monitorTimeStamp = time.Now().Add(-time.Hour)
+
+	target.probeCache.set(true)
+	if err := target.sync(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if time.Since(target.monitorTimeStamp) > time.Minute {
+		t.Errorf("expected the fallback countdown to have been paused while the installer lock was contended, monitorTimeStamp is still %s old", time.Since(target.monitorTimeStamp))
+	}
+}
+
+// TestIntegrationStartReportsSucceededOutcome runs the real StartupMonitor via Start against a
+// real filesystem and a health check that is healthy from the start, and asserts that once the
+// monitor is Stopped, Done reports it finished and Outcome reflects that the target succeeded.
+func TestIntegrationStartReportsSucceededOutcome(t *testing.T) {
+	target := newIntegrationTarget(t, 9)
+	target.WithSleepMode()
+	target.probeInterval = 10 * time.Millisecond
+	target.timeout = time.Minute
+	target.healthChecker = HealthCheckerFunc(func(ctx context.Context) (bool, string, error) { return true, "", nil })
+	writeIntegrationRevision(t, target, 9)
+
+	if err := os.WriteFile(target.rootManifestPath(), []byte(integrationPodManifest(9)), 0644); err != nil {
+		t.Fatalf("failed to write the root manifest: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	target.Start(ctx)
+
+	deadline := time.Now().Add(2 * time.Second)
+	for target.GetStatus().Phase != PhaseHealthy {
+		if time.Now().After(deadline) {
+			t.Fatalf("target never became healthy, last status: %+v", target.GetStatus())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	target.Stop()
+	select {
+	case <-target.Done():
+	case <-time.After(2 * time.Second):
+		t.Fatal("expected Done to close once Stop was called")
+	}
+
+	if err := target.Err(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if outcome := target.Outcome(); outcome != OutcomeSucceeded {
+		t.Errorf("unexpected outcome %v, expected %v", outcome, OutcomeSucceeded)
+	}
+}
+
+// TestIntegrationStartStopReportsAbortedOutcome runs the real StartupMonitor via Start against a
+// real filesystem and a health check that never reports healthy, and asserts that calling Stop
+// before the fallback deadline is reached closes Done and reports an Aborted outcome, rather than
+// blocking forever or reporting a spurious success or fallback.
+func TestIntegrationStartStopReportsAbortedOutcome(t *testing.T) {
+	target := newIntegrationTarget(t, 9)
+	writeIntegrationRevision(t, target, 8)
+	writeIntegrationRevision(t, target, 9)
+	target.probeInterval = 10 * time.Millisecond
+	target.timeout = time.Minute
+	target.healthChecker = HealthCheckerFunc(func(ctx context.Context) (bool, string, error) { return false, "not ready", nil })
+
+	if err := os.WriteFile(target.rootManifestPath(), []byte(integrationPodManifest(9)), 0644); err != nil {
+		t.Fatalf("failed to write the root manifest: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	target.Start(ctx)
+
+	time.Sleep(50 * time.Millisecond)
+	target.Stop()
+
+	select {
+	case <-target.Done():
+	case <-time.After(2 * time.Second):
+		t.Fatal("expected Done to close once Stop was called")
+	}
+
+	if err := target.Err(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if outcome := target.Outcome(); outcome != OutcomeAborted {
+		t.Errorf("unexpected outcome %v, expected %v", outcome, OutcomeAborted)
+	}
+}
+
+// TestIntegrationStateFileReflectsSuccessfulOutcome runs the real StartupMonitor against a real
+// filesystem with WithStateFileDir set, and asserts that once the target is observed healthy the
+// state file on disk reflects it, including the probe count recorded along the way.
+func TestIntegrationStateFileReflectsSuccessfulOutcome(t *testing.T) {
+	target := newIntegrationTarget(t, 9)
+	target.WithSleepMode()
+	stateFileDir := t.TempDir()
+	target.WithStateFileDir(stateFileDir)
+	target.probeInterval = 10 * time.Millisecond
+	target.timeout = time.Minute
+	target.healthChecker = HealthCheckerFunc(func(ctx context.Context) (bool, string, error) { return true, "", nil })
+	writeIntegrationRevision(t, target, 9)
+
+	if err := os.WriteFile(target.rootManifestPath(), []byte(integrationPodManifest(9)), 0644); err != nil {
+		t.Fatalf("failed to write the root manifest: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	target.Start(ctx)
+
+	deadline := time.Now().Add(2 * time.Second)
+	for target.GetStatus().Phase != PhaseHealthy {
+		if time.Now().After(deadline) {
+			t.Fatalf("target never became healthy, last status: %+v", target.GetStatus())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	target.Stop()
+	<-target.Done()
+
+	raw, err := os.ReadFile(path.Join(stateFileDir, "kube-apiserver.json"))
+	if err != nil {
+		t.Fatalf("failed to read the state file: %v", err)
+	}
+	record := StateFileRecord{}
+	if err := json.Unmarshal(raw, &record); err != nil {
+		t.Fatalf("failed to unmarshal the state file: %v", err)
+	}
+	if record.State != NodeStateSucceeded {
+		t.Errorf("unexpected state %q, expected %q", record.State, NodeStateSucceeded)
+	}
+	if record.TargetName != "kube-apiserver" {
+		t.Errorf("unexpected targetName %q", record.TargetName)
+	}
+	if record.Revision != 9 {
+		t.Errorf("unexpected revision %d, expected 9", record.Revision)
+	}
+	if record.ProbeCount == 0 {
+		t.Errorf("expected a non-zero probeCount")
+	}
+}
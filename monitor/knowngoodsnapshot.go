@@ -0,0 +1,214 @@
+package monitor
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+
+	"github.com/openshift/library-go/pkg/operator/resource/resourceread"
+)
+
+// dependentFilesAnnotation lists additional paths, comma-separated, that a target's manifest
+// depends on beyond the manifest itself, e.g. a TLS certificate directory or a config file not
+// already expressed as a hostPath volume. archiveKnownGoodSnapshot preserves them alongside the
+// manifest so a known-good snapshot doesn't depend on any external revision directory still
+// holding them. A directory entry is snapshotted one level deep only, matching
+// snapshotHostPathVolumes' scope limitation: IOInterface has no recursive directory copy.
+const dependentFilesAnnotation = "startup-monitor.static-pods.openshift.io/dependent-files"
+
+// knownGoodSnapshotManifestPathFor returns the path the manifest is archived to for revision,
+// independently of sm.layout, so the archive remains readable even if the Layout in use, or its
+// revision directories, later change or disappear.
+func (sm *StartupMonitor) knownGoodSnapshotManifestPathFor(revision int) string {
+	return path.Join(sm.staticPodResourcesPath, fmt.Sprintf("%s-known-good-snapshot.%d", sm.targetName, revision))
+}
+
+// knownGoodSnapshotIndexPathFor returns the path of the index file recording, in order, the
+// original paths of the dependent files archived alongside knownGoodSnapshotManifestPathFor.
+func (sm *StartupMonitor) knownGoodSnapshotIndexPathFor(revision int) string {
+	return sm.knownGoodSnapshotManifestPathFor(revision) + ".index"
+}
+
+// knownGoodSnapshotFilePathFor returns the path the i'th dependent path listed by
+// knownGoodSnapshotIndexPathFor is archived to.
+func (sm *StartupMonitor) knownGoodSnapshotFilePathFor(revision, i int) string {
+	return fmt.Sprintf("%s.file-%d", sm.knownGoodSnapshotManifestPathFor(revision), i)
+}
+
+// knownGoodSnapshotHistoryPath returns the path of the ledger recording, oldest first, the
+// revisions archiveKnownGoodSnapshot currently has a snapshot for, so it knows which one to
+// prune once knownGoodSnapshotRetention is exceeded.
+func (sm *StartupMonitor) knownGoodSnapshotHistoryPath() string {
+	return path.Join(sm.staticPodResourcesPath, fmt.Sprintf("%s-known-good-snapshot-history", sm.targetName))
+}
+
+// archiveKnownGoodSnapshot archives the manifest for revision, and any dependent files its
+// manifest declares via dependentFilesAnnotation, then prunes the oldest archived revision(s)
+// once more than knownGoodSnapshotRetention are on disk. It is a no-op when
+// knownGoodSnapshotRetention is 0, the default, and idempotent for a revision already archived.
+func (sm *StartupMonitor) archiveKnownGoodSnapshot(revision int) error {
+	if sm.knownGoodSnapshotRetention <= 0 {
+		return nil
+	}
+	if exists, err := sm.fileExists(sm.knownGoodSnapshotManifestPathFor(revision)); err != nil {
+		return err
+	} else if exists {
+		return nil
+	}
+
+	pod, err := sm.readTargetPod(sm.targetManifestPathFor(revision))
+	if err != nil {
+		return err
+	}
+
+	manifestBytes := []byte(resourceread.WritePodV1OrDie(pod))
+	if err := retryOnTransientError(func() error {
+		return sm.io.WriteFileAtomic(sm.knownGoodSnapshotManifestPathFor(revision), manifestBytes, 0644)
+	}); err != nil {
+		return err
+	}
+
+	dependentPaths := sm.dependentPathsFor(pod)
+	for i, dependentPath := range dependentPaths {
+		data, err := sm.io.ReadFile(dependentPath)
+		if err != nil {
+			klog.Warningf("Unable to read dependent path %q declared by %s revision %d, skipping its snapshot: %v", dependentPath, sm.targetName, revision, err)
+			continue
+		}
+		if err := retryOnTransientError(func() error {
+			return sm.io.WriteFile(sm.knownGoodSnapshotFilePathFor(revision, i), data, 0644)
+		}); err != nil {
+			klog.Warningf("Unable to snapshot dependent path %q declared by %s revision %d: %v", dependentPath, sm.targetName, revision, err)
+		}
+	}
+	if err := retryOnTransientError(func() error {
+		return sm.io.WriteFile(sm.knownGoodSnapshotIndexPathFor(revision), []byte(strings.Join(dependentPaths, "\n")), 0644)
+	}); err != nil {
+		klog.Warningf("Unable to record the dependent path index for %s revision %d: %v", sm.targetName, revision, err)
+	}
+
+	klog.Infof("Archived a known-good snapshot for %s revision %d (%d dependent file(s))", sm.targetName, revision, len(dependentPaths))
+	return sm.recordAndPruneKnownGoodSnapshotHistory(revision)
+}
+
+// dependentPathsFor parses dependentFilesAnnotation off pod, resolving any directory entry into
+// the regular files it directly contains.
+func (sm *StartupMonitor) dependentPathsFor(pod *corev1.Pod) []string {
+	raw, ok := pod.Annotations[dependentFilesAnnotation]
+	if !ok || strings.TrimSpace(raw) == "" {
+		return nil
+	}
+
+	var paths []string
+	for _, declared := range strings.Split(raw, ",") {
+		declared = strings.TrimSpace(declared)
+		if declared == "" {
+			continue
+		}
+
+		info, err := sm.io.Stat(declared)
+		if err != nil {
+			klog.Warningf("Unable to stat dependent path %q, skipping its snapshot: %v", declared, err)
+			continue
+		}
+		if !info.IsDir() {
+			paths = append(paths, declared)
+			continue
+		}
+
+		entries, err := sm.io.ReadDir(declared)
+		if err != nil {
+			klog.Warningf("Unable to list dependent directory %q, skipping its snapshot: %v", declared, err)
+			continue
+		}
+		for _, entry := range entries {
+			if entry.IsDir() {
+				continue
+			}
+			paths = append(paths, path.Join(declared, entry.Name()))
+		}
+	}
+	return paths
+}
+
+// verifyAndRepairDependentFiles checks that every dependent file archived for revision by
+// archiveKnownGoodSnapshot is still present on disk, restoring it from the archive when it is
+// missing. It relies on the archive, rather than re-resolving dependentFilesAnnotation off pod,
+// since dependentPathsFor only ever returns paths that already exist, which would silently miss
+// exactly the case this guards against. It is a no-op when knownGoodSnapshotRetention is 0, the
+// default, since there is then no archive to repair from.
+func (sm *StartupMonitor) verifyAndRepairDependentFiles(revision int) {
+	index, err := sm.io.ReadFile(sm.knownGoodSnapshotIndexPathFor(revision))
+	if err != nil {
+		return
+	}
+
+	for i, archivedPath := range strings.Split(string(index), "\n") {
+		archivedPath = strings.TrimSpace(archivedPath)
+		if archivedPath == "" {
+			continue
+		}
+
+		exists, err := sm.fileExists(archivedPath)
+		if err != nil {
+			klog.Warningf("Unable to verify dependent path %q declared by %s revision %d: %v", archivedPath, sm.targetName, revision, err)
+			continue
+		}
+		if exists {
+			continue
+		}
+
+		data, err := sm.io.ReadFile(sm.knownGoodSnapshotFilePathFor(revision, i))
+		if err != nil {
+			klog.Warningf("Dependent path %q declared by %s revision %d is missing and its known-good snapshot archive is unreadable: %v", archivedPath, sm.targetName, revision, err)
+			continue
+		}
+		if err := retryOnTransientError(func() error {
+			return sm.io.WriteFileAtomic(archivedPath, data, 0644)
+		}); err != nil {
+			klog.Warningf("Unable to repair missing dependent path %q declared by %s revision %d from its known-good snapshot archive: %v", archivedPath, sm.targetName, revision, err)
+			continue
+		}
+		klog.Infof("Repaired missing dependent path %q declared by %s revision %d from its known-good snapshot archive", archivedPath, sm.targetName, revision)
+	}
+}
+
+// recordAndPruneKnownGoodSnapshotHistory appends revision to the known-good snapshot ledger and
+// removes the oldest archived revision(s) once knownGoodSnapshotRetention is exceeded.
+func (sm *StartupMonitor) recordAndPruneKnownGoodSnapshotHistory(revision int) error {
+	history, err := sm.readRevisionLedger(sm.knownGoodSnapshotHistoryPath())
+	if err != nil {
+		return err
+	}
+	history = append(history, revision)
+
+	for len(history) > sm.knownGoodSnapshotRetention {
+		sm.removeKnownGoodSnapshot(history[0])
+		history = history[1:]
+	}
+
+	return sm.writeRevisionLedger(sm.knownGoodSnapshotHistoryPath(), history)
+}
+
+// removeKnownGoodSnapshot removes the manifest, index and dependent file archives for revision.
+func (sm *StartupMonitor) removeKnownGoodSnapshot(revision int) {
+	if data, err := sm.io.ReadFile(sm.knownGoodSnapshotIndexPathFor(revision)); err == nil {
+		for i, line := range strings.Split(string(data), "\n") {
+			if strings.TrimSpace(line) == "" {
+				continue
+			}
+			if err := sm.io.Remove(sm.knownGoodSnapshotFilePathFor(revision, i)); err != nil {
+				klog.Warningf("Unable to remove a stale known-good snapshot dependent file for %s revision %d: %v", sm.targetName, revision, err)
+			}
+		}
+	}
+	if err := sm.io.Remove(sm.knownGoodSnapshotIndexPathFor(revision)); err != nil {
+		klog.Warningf("Unable to remove the stale known-good snapshot index for %s revision %d: %v", sm.targetName, revision, err)
+	}
+	if err := sm.io.Remove(sm.knownGoodSnapshotManifestPathFor(revision)); err != nil {
+		klog.Warningf("Unable to remove the stale known-good snapshot manifest for %s revision %d: %v", sm.targetName, revision, err)
+	}
+}
@@ -0,0 +1,41 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// nodeLeaseNamespace is where the kubelet renews its per-node Lease object.
+const nodeLeaseNamespace = "kube-node-lease"
+
+// kubeletLeaseStaleThreshold is how far behind the current time a node's Lease renewal may
+// lag before the kubelet on this node is considered not alive.
+const kubeletLeaseStaleThreshold = 40 * time.Second
+
+// isKubeletAlive reports whether the kubelet on this node is renewing its Lease object, the
+// same signal the node lifecycle controller uses to decide node readiness. It requires
+// sm.client; when it is unset it reports true so the monitor's timeout behavior is
+// unaffected when no client is wired up.
+func (sm *StartupMonitor) isKubeletAlive(ctx context.Context) (bool, error) {
+	if !sm.hasClient() {
+		return true, nil
+	}
+
+	nodeName, err := nodeName()
+	if err != nil {
+		return false, fmt.Errorf("failed to determine the node name: %v", err)
+	}
+
+	lease, err := sm.client.CoordinationV1().Leases(nodeLeaseNamespace).Get(ctx, nodeName, metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+	if lease.Spec.RenewTime == nil {
+		return false, nil
+	}
+
+	return time.Since(lease.Spec.RenewTime.Time) < kubeletLeaseStaleThreshold, nil
+}
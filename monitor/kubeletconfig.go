@@ -0,0 +1,37 @@
+package monitor
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/yaml"
+)
+
+// kubeletConfiguration is the small subset of the kubelet's config file this package cares
+// about: where it looks for static pod manifests.
+type kubeletConfiguration struct {
+	StaticPodPath string `json:"staticPodPath"`
+}
+
+// staticPodPathFromKubeletConfig reads the kubelet's config file at kubeletConfigFile and
+// returns its configured staticPodPath, so deployments with a relocated manifest directory
+// don't need to duplicate that path as a startup-monitor flag.
+func staticPodPathFromKubeletConfig(io IOInterface, kubeletConfigFile string) (string, error) {
+	if io == nil {
+		io = realFS{}
+	}
+
+	raw, err := io.ReadFile(kubeletConfigFile)
+	if err != nil {
+		return "", err
+	}
+
+	var config kubeletConfiguration
+	if err := yaml.Unmarshal(raw, &config); err != nil {
+		return "", fmt.Errorf("failed to parse kubelet config %q: %v", kubeletConfigFile, err)
+	}
+	if len(config.StaticPodPath) == 0 {
+		return "", fmt.Errorf("kubelet config %q doesn't set staticPodPath", kubeletConfigFile)
+	}
+
+	return config.StaticPodPath, nil
+}
@@ -0,0 +1,99 @@
+package monitor
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// kubeletServiceAccountTokenFile is where an in-cluster pod's own service account token is
+// projected, used to authenticate against the kubelet's secure /pods endpoint. It's the same
+// path client-go's rest.InClusterConfig reads.
+const kubeletServiceAccountTokenFile = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+
+// kubeletPodsHealthChecker returns a HealthChecker that queries the local kubelet's own /pods
+// endpoint on port, finds the mirror pod for targetName in namespace, and reports healthy iff
+// it's Ready and none of its containers have restarted more than maxRestartCount times (a
+// negative maxRestartCount disables the restart-count check). Unlike isMirrorPodReady, which
+// asks the apiserver, this asks the kubelet directly, giving a kubelet's-eye view of health that
+// survives an apiserver outage and catches restart-loop churn that a single readiness snapshot
+// misses.
+func kubeletPodsHealthChecker(port int, targetName, namespace string, maxRestartCount int) HealthChecker {
+	client := &http.Client{
+		Timeout: 5 * time.Second,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		},
+	}
+	url := fmt.Sprintf("https://localhost:%d/pods", port)
+
+	return HealthCheckerFunc(func(ctx context.Context) (bool, string, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return false, "", err
+		}
+		if token, err := os.ReadFile(kubeletServiceAccountTokenFile); err == nil {
+			req.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(token)))
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return false, fmt.Sprintf("GET %s failed: %v", url, err), nil
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return false, fmt.Sprintf("GET %s returned HTTP %d", url, resp.StatusCode), nil
+		}
+
+		var podList corev1.PodList
+		if err := json.NewDecoder(resp.Body).Decode(&podList); err != nil {
+			return false, fmt.Sprintf("GET %s returned an unparsable body: %v", url, err), nil
+		}
+
+		nodeName, err := nodeName()
+		if err != nil {
+			return false, "", err
+		}
+		mirrorPodName := fmt.Sprintf("%s-%s", targetName, nodeName)
+
+		for i := range podList.Items {
+			pod := &podList.Items[i]
+			if pod.Namespace != namespace || pod.Name != mirrorPodName {
+				continue
+			}
+			return evaluateKubeletPodHealth(pod, maxRestartCount)
+		}
+		return false, fmt.Sprintf("mirror pod %s/%s not found among the kubelet's pods", namespace, mirrorPodName), nil
+	})
+}
+
+// evaluateKubeletPodHealth reports whether pod, as seen by the kubelet, is Ready and hasn't
+// restarted more than maxRestartCount times (a negative maxRestartCount disables that check).
+func evaluateKubeletPodHealth(pod *corev1.Pod, maxRestartCount int) (bool, string, error) {
+	ready := false
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			ready = condition.Status == corev1.ConditionTrue
+			break
+		}
+	}
+	if !ready {
+		return false, fmt.Sprintf("mirror pod %s/%s is not Ready", pod.Namespace, pod.Name), nil
+	}
+
+	if maxRestartCount >= 0 {
+		for _, status := range pod.Status.ContainerStatuses {
+			if int(status.RestartCount) > maxRestartCount {
+				return false, fmt.Sprintf("container %q in mirror pod %s/%s has restarted %d times, exceeding the limit of %d", status.Name, pod.Namespace, pod.Name, status.RestartCount, maxRestartCount), nil
+			}
+		}
+	}
+	return true, "", nil
+}
@@ -0,0 +1,83 @@
+package monitor
+
+import (
+	"fmt"
+	"path"
+)
+
+// Layout abstracts the directory scheme used to locate the target's root and revisioned
+// static pod manifests, so that alternative directory schemes can be plugged in without
+// scattering fmt.Sprintf templates across the monitor.
+type Layout interface {
+	// RootManifestPath returns the path of the root manifest for targetName under
+	// manifestsPath, the manifest the kubelet actually watches.
+	RootManifestPath(manifestsPath, targetName string) string
+
+	// TargetManifestPathFor returns the path of the revisioned manifest for targetName at
+	// the given revision, under staticPodResourcesPath.
+	TargetManifestPathFor(staticPodResourcesPath, targetName string, revision int) string
+
+	// LastKnownGoodManifestDstPath returns the path of the symlink, under
+	// staticPodResourcesPath, that points at the revisioned manifest currently considered
+	// the last known good one for targetName.
+	LastKnownGoodManifestDstPath(staticPodResourcesPath, targetName string) string
+
+	// RequiresSelfManagedSnapshots reports whether this layout relies on the monitor itself
+	// to preserve a revisioned copy of the manifest (and the files it references) before a
+	// revision is monitored, rather than an external installer having already placed one on
+	// disk. See StartupMonitor.snapshotCurrentManifestIfNeeded.
+	RequiresSelfManagedSnapshots() bool
+}
+
+// openshiftLayout is the default Layout. It matches the directory structure created by an
+// OCP installation: root manifests live under manifestsPath, revisioned manifests live
+// under staticPodResourcesPath/<target>-pod-<revision>/, and the last known good revision
+// is recorded as a symlink alongside them.
+type openshiftLayout struct{}
+
+func (openshiftLayout) RootManifestPath(manifestsPath, targetName string) string {
+	return path.Join(manifestsPath, fmt.Sprintf("%s-pod.yaml", targetName))
+}
+
+func (openshiftLayout) TargetManifestPathFor(staticPodResourcesPath, targetName string, revision int) string {
+	return path.Join(staticPodResourcesPath, fmt.Sprintf("%s-pod-%d", targetName, revision), fmt.Sprintf("%s-pod.yaml", targetName))
+}
+
+func (openshiftLayout) LastKnownGoodManifestDstPath(staticPodResourcesPath, targetName string) string {
+	return path.Join(staticPodResourcesPath, fmt.Sprintf("%s-last-known-good", targetName))
+}
+
+// RequiresSelfManagedSnapshots is false: the installer already places a revisioned copy under
+// staticPodResourcesPath/<target>-pod-<revision>/ before the root manifest is updated.
+func (openshiftLayout) RequiresSelfManagedSnapshots() bool {
+	return false
+}
+
+// kubeadmLayout matches the directory structure created by a vanilla kubeadm installation:
+// there are no per-revision resource directories, just a single flat manifests directory
+// (conventionally /etc/kubernetes/manifests) holding one file per static pod, named
+// "<target>.yaml". Since kubeadm never keeps a revision's manifest around once it has been
+// overwritten, revisioned and last-known-good copies are kept alongside it, as
+// "<target>.yaml.<revision>" and "<target>.yaml.last-known-good". Because nothing external
+// creates those revisioned copies, the monitor snapshots them itself; see
+// RequiresSelfManagedSnapshots and StartupMonitor.snapshotCurrentManifestIfNeeded.
+type kubeadmLayout struct{}
+
+func (kubeadmLayout) RootManifestPath(manifestsPath, targetName string) string {
+	return path.Join(manifestsPath, fmt.Sprintf("%s.yaml", targetName))
+}
+
+func (kubeadmLayout) TargetManifestPathFor(staticPodResourcesPath, targetName string, revision int) string {
+	return path.Join(staticPodResourcesPath, fmt.Sprintf("%s.yaml.%d", targetName, revision))
+}
+
+func (kubeadmLayout) LastKnownGoodManifestDstPath(staticPodResourcesPath, targetName string) string {
+	return path.Join(staticPodResourcesPath, fmt.Sprintf("%s.yaml.last-known-good", targetName))
+}
+
+// RequiresSelfManagedSnapshots is true: kubeadm overwrites the manifest in place with no
+// revisioned copy left behind, so the monitor must snapshot it (and any host path files it
+// references) itself before a rollback could ever need it.
+func (kubeadmLayout) RequiresSelfManagedSnapshots() bool {
+	return true
+}
@@ -0,0 +1,68 @@
+package monitor
+
+import (
+	"testing"
+
+	"github.com/p0lyn0mial/startup-monitor/monitor/monitortesting"
+)
+
+func TestLayoutForName(t *testing.T) {
+	scenarios := []struct {
+		name           string
+		layoutName     string
+		expectedLayout Layout
+		expectedErr    string
+	}{
+		// scenario 1
+		{
+			name:           "empty defaults to openshift",
+			layoutName:     "",
+			expectedLayout: openshiftLayout{},
+		},
+
+		// scenario 2
+		{
+			name:           "openshift",
+			layoutName:     "openshift",
+			expectedLayout: openshiftLayout{},
+		},
+
+		// scenario 3
+		{
+			name:           "kubeadm",
+			layoutName:     "kubeadm",
+			expectedLayout: kubeadmLayout{},
+		},
+
+		// scenario 4
+		{
+			name:        "unknown layout",
+			layoutName:  "vanilla",
+			expectedErr: `unknown --layout "vanilla", must be one of: openshift, kubeadm`,
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			layout, err := layoutForName(scenario.layoutName)
+			monitortesting.AssertError(t, err, scenario.expectedErr)
+			if layout != scenario.expectedLayout {
+				t.Errorf("unexpected layout %#v, expected %#v", layout, scenario.expectedLayout)
+			}
+		})
+	}
+}
+
+func TestKubeadmLayout(t *testing.T) {
+	layout := kubeadmLayout{}
+
+	if got, expected := layout.RootManifestPath("/etc/kubernetes/manifests", "kube-apiserver"), "/etc/kubernetes/manifests/kube-apiserver.yaml"; got != expected {
+		t.Errorf("unexpected root manifest path %q, expected %q", got, expected)
+	}
+	if got, expected := layout.TargetManifestPathFor("/etc/kubernetes/manifests", "kube-apiserver", 3), "/etc/kubernetes/manifests/kube-apiserver.yaml.3"; got != expected {
+		t.Errorf("unexpected revisioned manifest path %q, expected %q", got, expected)
+	}
+	if got, expected := layout.LastKnownGoodManifestDstPath("/etc/kubernetes/manifests", "kube-apiserver"), "/etc/kubernetes/manifests/kube-apiserver.yaml.last-known-good"; got != expected {
+		t.Errorf("unexpected last known good path %q, expected %q", got, expected)
+	}
+}
@@ -0,0 +1,68 @@
+package monitor
+
+import "context"
+
+// Outcome reports the terminal state of a StartupMonitor run, valid once Done is closed (or,
+// for a monitor driven directly via Run instead of Start, once Run has returned).
+type Outcome string
+
+const (
+	// OutcomeSucceeded means the target was observed healthy and no fallback was needed.
+	OutcomeSucceeded Outcome = "Succeeded"
+	// OutcomeFallbackPerformed means the target timed out and the monitor fell back to the
+	// previous revision.
+	OutcomeFallbackPerformed Outcome = "FallbackPerformed"
+	// OutcomeAborted means the monitor shut down before reaching either verdict above, e.g.
+	// because Stop was called or the context passed to Start was cancelled before the target
+	// became healthy, a fallback was vetoed by a FallbackGuardFunc, or a sync error prevented
+	// one from completing.
+	OutcomeAborted Outcome = "Aborted"
+)
+
+// Start begins running the monitor in a background goroutine and returns immediately, for an
+// embedding operator that wants to react to other events while the monitor runs instead of
+// blocking on Run. It must not be called more than once on the same StartupMonitor, and must be
+// paired with a receive on Done to observe termination and release the goroutine.
+func (sm *StartupMonitor) Start(ctx context.Context) {
+	ctx, sm.cancelStart = context.WithCancel(ctx)
+	go func() {
+		defer close(sm.done)
+		sm.startErr = sm.Run(ctx)
+	}()
+}
+
+// Stop requests that a monitor started with Start shut down, the same way cancelling its own
+// context would, without the caller having to hold onto that context itself. It does not wait
+// for the shutdown to finish; a caller that needs to should follow it with <-sm.Done().
+func (sm *StartupMonitor) Stop() {
+	if sm.cancelStart != nil {
+		sm.cancelStart()
+	}
+}
+
+// Done returns a channel that is closed once a monitor started with Start has finished
+// running, so an embedding operator can select on it alongside its own shutdown machinery
+// instead of blocking on Run.
+func (sm *StartupMonitor) Done() <-chan struct{} {
+	return sm.done
+}
+
+// Err returns the error Run returned for a monitor started with Start, valid once Done is
+// closed.
+func (sm *StartupMonitor) Err() error {
+	return sm.startErr
+}
+
+// Outcome classifies the monitor's most recent GetStatus phase into the coarser terminal states
+// an embedding operator cares about once it's done running, rather than the finer-grained Phase
+// used for status reporting while it's still in progress.
+func (sm *StartupMonitor) Outcome() Outcome {
+	switch sm.GetStatus().Phase {
+	case PhaseHealthy:
+		return OutcomeSucceeded
+	case PhaseFallback:
+		return OutcomeFallbackPerformed
+	default:
+		return OutcomeAborted
+	}
+}
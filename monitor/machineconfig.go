@@ -0,0 +1,40 @@
+package monitor
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// machineConfigStateAnnotation is the node annotation the Machine Config Operator uses to
+// report its current state for the node, including while applying an update (which may
+// involve an rpm-ostree OS update).
+const machineConfigStateAnnotation = "machineconfiguration.openshift.io/state"
+
+// machineConfigStateWorking is the machineConfigStateAnnotation value reported while the
+// Machine Config Operator is actively applying an update on the node.
+const machineConfigStateWorking = "Working"
+
+// isMachineConfigUpdateInProgress reports whether the Machine Config Operator is currently
+// applying an update on this node. Operand slowness during such an update is expected, and a
+// fallback would only be noise.
+//
+// This is an additional, optional signal: it requires sm.client, and when no client is
+// configured it reports false so the fallback countdown behaves exactly as before.
+func (sm *StartupMonitor) isMachineConfigUpdateInProgress(ctx context.Context) (bool, error) {
+	if !sm.hasClient() {
+		return false, nil
+	}
+
+	nodeName, err := nodeName()
+	if err != nil {
+		return false, err
+	}
+
+	node, err := sm.client.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	return node.Annotations[machineConfigStateAnnotation] == machineConfigStateWorking, nil
+}
@@ -0,0 +1,28 @@
+package monitor
+
+import (
+	"os"
+	"syscall"
+)
+
+// fileIdentity captures enough of a file's stat info to tell whether its content may have
+// changed since it was last read, without re-reading it.
+type fileIdentity struct {
+	modTime int64
+	size    int64
+	inode   uint64
+}
+
+// fileIdentityOf extracts a fileIdentity from info. The inode is best-effort: on platforms
+// where the underlying Sys() value isn't a *syscall.Stat_t it is left at 0, which only means
+// two files can't be told apart by inode alone, not that the cache misbehaves.
+func fileIdentityOf(info os.FileInfo) fileIdentity {
+	identity := fileIdentity{
+		modTime: info.ModTime().UnixNano(),
+		size:    info.Size(),
+	}
+	if stat, ok := info.Sys().(*syscall.Stat_t); ok {
+		identity.inode = stat.Ino
+	}
+	return identity
+}
@@ -0,0 +1,104 @@
+package monitor
+
+import (
+	"encoding/binary"
+	"path"
+
+	"golang.org/x/sys/unix"
+	"k8s.io/klog/v2"
+)
+
+// manifestWatchBufferSize is sized to hold several raw inotify events (each
+// unix.SizeofInotifyEvent bytes plus a variable-length, NUL-padded name) without needing to
+// grow; a burst larger than this is still handled correctly, just across more than one Read.
+const manifestWatchBufferSize = 4096
+
+// manifestWatchMask covers everything an installer can do to the root manifest short of
+// reading it: writing it in place, replacing it via a temp-file-and-rename, or removing it
+// outright.
+const manifestWatchMask = unix.IN_CREATE | unix.IN_MODIFY | unix.IN_DELETE | unix.IN_MOVED_TO | unix.IN_MOVED_FROM | unix.IN_ATTRIB
+
+// runManifestWatchLoop watches the directory holding the target's root manifest via inotify
+// and sends on triggerCh whenever the root manifest is created, written, moved, or removed, so
+// the sync loop can react immediately instead of waiting out the rest of the current
+// probeInterval. It watches the directory rather than the manifest file itself because an
+// installer typically replaces the manifest by writing a temp file and renaming it into place,
+// which would invalidate a watch held directly on the old inode.
+//
+// It is purely an optimization: the sync loop's own periodic tick, driven by wait.JitterUntil
+// in Run, keeps working exactly as before regardless of whether this loop manages to establish
+// a watch. If inotify is unavailable, or the directory doesn't exist yet, it logs once and
+// returns without ever sending on triggerCh, leaving the caller to rely on polling alone.
+func (sm *StartupMonitor) runManifestWatchLoop(stopCh <-chan struct{}, triggerCh chan<- struct{}) {
+	fd, err := unix.InotifyInit1(unix.IN_CLOEXEC)
+	if err != nil {
+		klog.Warningf("Unable to initialize an inotify watch for %s, falling back to polling only: %v", sm.targetName, err)
+		return
+	}
+
+	if _, err := unix.InotifyAddWatch(fd, sm.manifestsPath, manifestWatchMask); err != nil {
+		klog.Warningf("Unable to watch %q for %s, falling back to polling only: %v", sm.manifestsPath, sm.targetName, err)
+		_ = unix.Close(fd)
+		return
+	}
+
+	// inotify offers no select-friendly integration with a channel or context, so the only
+	// way to unblock the Read below once stopCh closes is to close fd out from under it; this
+	// goroutine, not runManifestWatchLoop itself, owns closing fd from here on.
+	go func() {
+		<-stopCh
+		_ = unix.Close(fd)
+	}()
+
+	rootManifestName := path.Base(sm.rootManifestPath())
+	buf := make([]byte, manifestWatchBufferSize)
+	for {
+		n, err := unix.Read(fd, buf)
+		if err != nil {
+			// either stopCh closed fd above, or the watch is otherwise no longer usable;
+			// either way, there's nothing left to do but stop watching.
+			return
+		}
+		if !manifestEventsMatchName(buf[:n], rootManifestName) {
+			continue
+		}
+		select {
+		case triggerCh <- struct{}{}:
+		default:
+			// a trigger is already pending; the next sync iteration reads whatever is on
+			// disk by then regardless of how many events coalesce into it.
+		}
+	}
+}
+
+// manifestEventsMatchName reports whether raw, one or more concatenated unix.InotifyEvent
+// records as returned by a single inotify fd Read, contains an event naming a file called
+// name. Event names are decoded without unix.InotifyEvent's unsafe.Pointer cast, so a
+// malformed or truncated trailing record just stops the scan instead of risking an out-of-
+// bounds read.
+func manifestEventsMatchName(raw []byte, name string) bool {
+	for len(raw) >= unix.SizeofInotifyEvent {
+		nameLen := int(binary.LittleEndian.Uint32(raw[12:16]))
+		nameStart := unix.SizeofInotifyEvent
+		nameEnd := nameStart + nameLen
+		if nameEnd > len(raw) {
+			return false
+		}
+		if trimNulPadding(raw[nameStart:nameEnd]) == name {
+			return true
+		}
+		raw = raw[nameEnd:]
+	}
+	return false
+}
+
+// trimNulPadding strips the trailing NUL bytes inotify pads a variable-length event name with
+// to align the next record.
+func trimNulPadding(b []byte) string {
+	for i, c := range b {
+		if c == 0 {
+			return string(b[:i])
+		}
+	}
+	return string(b)
+}
@@ -0,0 +1,35 @@
+package monitor
+
+import "github.com/prometheus/client_golang/prometheus"
+
+// syncPanicsTotal counts panics recovered from a sync iteration. A panic in a
+// custom health function (or anywhere else in sync) must not permanently kill
+// the guard on a control-plane node, so it is recovered, counted here and the
+// sync loop simply continues on the next tick.
+var syncPanicsTotal = prometheus.NewCounter(prometheus.CounterOpts{
+	Name: "startup_monitor_sync_panics_total",
+	Help: "Total number of panics recovered from a startup monitor sync iteration.",
+})
+
+// syncOverrunsTotal counts sync iterations that took longer than the probe interval. Each
+// iteration is bounded by a context with a probe-interval deadline, so an overrun means a
+// filesystem or network operation ignored cancellation rather than the loop stalling
+// indefinitely, but it's still worth watching for since it eats into the fallback deadline.
+var syncOverrunsTotal = prometheus.NewCounter(prometheus.CounterOpts{
+	Name: "startup_monitor_sync_overruns_total",
+	Help: "Total number of startup monitor sync iterations that took longer than the probe interval.",
+})
+
+// syncErrorsTotal counts sync iterations that returned an error, by SyncErrorCategory, making
+// it possible to alert on a monitor that is erroring continuously on one specific operation
+// rather than actually monitoring, as opposed to one hitting occasional transient errors.
+var syncErrorsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "startup_monitor_sync_errors_total",
+	Help: "Total number of startup monitor sync iterations that returned an error, by category.",
+}, []string{"category"})
+
+func init() {
+	prometheus.MustRegister(syncPanicsTotal)
+	prometheus.MustRegister(syncOverrunsTotal)
+	prometheus.MustRegister(syncErrorsTotal)
+}
@@ -0,0 +1,18 @@
+package monitortesting
+
+import "testing"
+
+// AssertError fails t unless actualErr's message matches expectedErr, or,
+// when expectedErr is empty, unless actualErr is nil.
+func AssertError(t *testing.T, actualErr error, expectedErr string) {
+	t.Helper()
+	if actualErr != nil && len(expectedErr) == 0 {
+		t.Fatalf("unexpected error %v", actualErr)
+	}
+	if actualErr == nil && len(expectedErr) > 0 {
+		t.Fatal("expected to get an error")
+	}
+	if actualErr != nil && actualErr.Error() != expectedErr {
+		t.Fatalf("incorrect error: %v, expected: %v", actualErr, expectedErr)
+	}
+}
@@ -0,0 +1,269 @@
+package monitortesting
+
+import (
+	"bytes"
+	"os"
+	"path"
+	"testing"
+)
+
+// RunIOConformanceSuite runs a suite of subtests that any IOBackend implementation must pass to
+// behave like realFS for symlinks, stat-of-symlink, and error types, so a custom backend an
+// embedder supplies (in place of realFS, or layered like FaultInjectingIO) can be trusted to
+// behave identically for the handful of behaviors the monitor's fallback logic depends on.
+//
+// newBackend is called once per subtest with a fresh, empty directory and must return a backend
+// rooted so that paths under dir are visible to it; most implementations can ignore dir and
+// simply return themselves, since IOBackend methods take absolute paths.
+func RunIOConformanceSuite(t *testing.T, newBackend func(dir string) IOBackend) {
+	t.Helper()
+
+	t.Run("StatOfMissingPathReturnsIsNotExist", func(t *testing.T) {
+		dir := t.TempDir()
+		backend := newBackend(dir)
+
+		_, err := backend.Stat(path.Join(dir, "missing"))
+		if !os.IsNotExist(err) {
+			t.Fatalf("expected an IsNotExist error, got %v", err)
+		}
+	})
+
+	t.Run("RemoveOfMissingPathReturnsIsNotExist", func(t *testing.T) {
+		dir := t.TempDir()
+		backend := newBackend(dir)
+
+		err := backend.Remove(path.Join(dir, "missing"))
+		if !os.IsNotExist(err) {
+			t.Fatalf("expected an IsNotExist error, got %v", err)
+		}
+	})
+
+	t.Run("WriteThenReadFileRoundTrips", func(t *testing.T) {
+		dir := t.TempDir()
+		backend := newBackend(dir)
+
+		filePath := path.Join(dir, "file")
+		want := []byte("hello world")
+		if err := backend.WriteFile(filePath, want, 0644); err != nil {
+			t.Fatalf("unexpected error writing: %v", err)
+		}
+
+		got, err := backend.ReadFile(filePath)
+		if err != nil {
+			t.Fatalf("unexpected error reading: %v", err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("got %q, expected %q", got, want)
+		}
+	})
+
+	t.Run("StatOfWrittenFileReportsNotADirectory", func(t *testing.T) {
+		dir := t.TempDir()
+		backend := newBackend(dir)
+
+		filePath := path.Join(dir, "file")
+		if err := backend.WriteFile(filePath, []byte("data"), 0644); err != nil {
+			t.Fatalf("unexpected error writing: %v", err)
+		}
+
+		info, err := backend.Stat(filePath)
+		if err != nil {
+			t.Fatalf("unexpected error stating: %v", err)
+		}
+		if info.IsDir() {
+			t.Errorf("expected a regular file, got a directory")
+		}
+	})
+
+	t.Run("SymlinkThenStatAndReadFileFollowToTarget", func(t *testing.T) {
+		dir := t.TempDir()
+		backend := newBackend(dir)
+
+		targetPath := path.Join(dir, "target")
+		want := []byte("target contents")
+		if err := backend.WriteFile(targetPath, want, 0644); err != nil {
+			t.Fatalf("unexpected error writing the target: %v", err)
+		}
+
+		linkPath := path.Join(dir, "link")
+		if err := backend.Symlink(targetPath, linkPath); err != nil {
+			t.Fatalf("unexpected error symlinking: %v", err)
+		}
+
+		info, err := backend.Stat(linkPath)
+		if err != nil {
+			t.Fatalf("expected Stat to follow the symlink to its target, got: %v", err)
+		}
+		if info.IsDir() {
+			t.Errorf("expected the resolved target to be a regular file")
+		}
+
+		got, err := backend.ReadFile(linkPath)
+		if err != nil {
+			t.Fatalf("expected ReadFile to follow the symlink to its target, got: %v", err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("got %q via the symlink, expected %q", got, want)
+		}
+	})
+
+	t.Run("StatOfDanglingSymlinkReturnsIsNotExist", func(t *testing.T) {
+		dir := t.TempDir()
+		backend := newBackend(dir)
+
+		linkPath := path.Join(dir, "link")
+		if err := backend.Symlink(path.Join(dir, "never-created"), linkPath); err != nil {
+			t.Fatalf("unexpected error symlinking: %v", err)
+		}
+
+		if _, err := backend.Stat(linkPath); !os.IsNotExist(err) {
+			t.Fatalf("expected Stat of a dangling symlink to report IsNotExist, got %v", err)
+		}
+	})
+
+	t.Run("ReadDirListsWrittenEntries", func(t *testing.T) {
+		dir := t.TempDir()
+		backend := newBackend(dir)
+
+		if err := backend.WriteFile(path.Join(dir, "file-a"), []byte("a"), 0644); err != nil {
+			t.Fatalf("unexpected error writing file-a: %v", err)
+		}
+		if err := os.Mkdir(path.Join(dir, "subdir"), 0755); err != nil {
+			t.Fatalf("unexpected error creating subdir: %v", err)
+		}
+
+		entries, err := backend.ReadDir(dir)
+		if err != nil {
+			t.Fatalf("unexpected error reading the directory: %v", err)
+		}
+
+		var sawFile, sawDir bool
+		for _, entry := range entries {
+			switch entry.Name() {
+			case "file-a":
+				sawFile = true
+				if entry.IsDir() {
+					t.Errorf("expected file-a to be reported as a regular file")
+				}
+			case "subdir":
+				sawDir = true
+				if !entry.IsDir() {
+					t.Errorf("expected subdir to be reported as a directory")
+				}
+			}
+		}
+		if !sawFile {
+			t.Errorf("expected ReadDir to list file-a")
+		}
+		if !sawDir {
+			t.Errorf("expected ReadDir to list subdir")
+		}
+	})
+
+	t.Run("WriteFileAtomicThenReadFileRoundTripsAndLeavesNoTempFile", func(t *testing.T) {
+		dir := t.TempDir()
+		backend := newBackend(dir)
+
+		filePath := path.Join(dir, "file")
+		want := []byte("hello atomic world")
+		if err := backend.WriteFileAtomic(filePath, want, 0644); err != nil {
+			t.Fatalf("unexpected error writing: %v", err)
+		}
+
+		got, err := backend.ReadFile(filePath)
+		if err != nil {
+			t.Fatalf("unexpected error reading: %v", err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("got %q, expected %q", got, want)
+		}
+
+		if _, err := backend.Stat(path.Join(dir, ".file.tmp")); !os.IsNotExist(err) {
+			t.Errorf("expected the temp file to be gone after a successful write, stat returned: %v", err)
+		}
+	})
+
+	t.Run("RenameMovesFile", func(t *testing.T) {
+		dir := t.TempDir()
+		backend := newBackend(dir)
+
+		oldPath := path.Join(dir, "old")
+		newPath := path.Join(dir, "new")
+		want := []byte("data")
+		if err := backend.WriteFile(oldPath, want, 0644); err != nil {
+			t.Fatalf("unexpected error writing: %v", err)
+		}
+
+		if err := backend.Rename(oldPath, newPath); err != nil {
+			t.Fatalf("unexpected error renaming: %v", err)
+		}
+
+		if _, err := backend.Stat(oldPath); !os.IsNotExist(err) {
+			t.Errorf("expected the old path to be gone, stat returned: %v", err)
+		}
+		got, err := backend.ReadFile(newPath)
+		if err != nil {
+			t.Fatalf("unexpected error reading the renamed file: %v", err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("got %q, expected %q", got, want)
+		}
+	})
+
+	t.Run("ChmodChangesFileMode", func(t *testing.T) {
+		dir := t.TempDir()
+		backend := newBackend(dir)
+
+		filePath := path.Join(dir, "file")
+		if err := backend.WriteFile(filePath, []byte("data"), 0644); err != nil {
+			t.Fatalf("unexpected error writing: %v", err)
+		}
+
+		if err := backend.Chmod(filePath, 0600); err != nil {
+			t.Fatalf("unexpected error chmod-ing: %v", err)
+		}
+
+		info, err := backend.Stat(filePath)
+		if err != nil {
+			t.Fatalf("unexpected error stating: %v", err)
+		}
+		if info.Mode().Perm() != 0600 {
+			t.Errorf("got mode %v, expected 0600", info.Mode().Perm())
+		}
+	})
+
+	t.Run("EvalSymlinksResolvesToTarget", func(t *testing.T) {
+		dir := t.TempDir()
+		backend := newBackend(dir)
+
+		targetPath := path.Join(dir, "target")
+		if err := backend.WriteFile(targetPath, []byte("data"), 0644); err != nil {
+			t.Fatalf("unexpected error writing the target: %v", err)
+		}
+		linkPath := path.Join(dir, "link")
+		if err := backend.Symlink(targetPath, linkPath); err != nil {
+			t.Fatalf("unexpected error symlinking: %v", err)
+		}
+
+		resolved, err := backend.EvalSymlinks(linkPath)
+		if err != nil {
+			t.Fatalf("unexpected error resolving the symlink: %v", err)
+		}
+		if resolved != targetPath {
+			t.Errorf("got %q, expected %q", resolved, targetPath)
+		}
+	})
+
+	t.Run("AvailableBytesReportsNonZero", func(t *testing.T) {
+		dir := t.TempDir()
+		backend := newBackend(dir)
+
+		available, err := backend.AvailableBytes(dir)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if available == 0 {
+			t.Errorf("expected a non-zero number of available bytes on a real file system")
+		}
+	})
+}
@@ -0,0 +1,212 @@
+// Package monitortesting provides fakes for exercising the startup monitor's
+// file system dependencies in unit tests, so that operators embedding the
+// monitor package don't have to copy-paste them from this repo's own tests.
+package monitortesting
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"time"
+
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+// FakeIO is a test double for the monitor's file system operations. Each
+// operation records how many times it was invoked so that callers can assert
+// on the *FnCounter fields; a nil Fn falls back to a no-op default.
+type FakeIO struct {
+	StatFn                func(string) (os.FileInfo, error)
+	StatFnCounter         int
+	ExpectedStatFnCounter int
+
+	SymlinkFn                func(string, string) error
+	SymlinkFnCounter         int
+	ExpectedSymlinkFnCounter int
+
+	RemoveFn                func(string) error
+	RemoveFnCounter         int
+	ExpectedRemoveFnCounter int
+
+	ReadFileFn                func(string) ([]byte, error)
+	ReadFileFnCounter         int
+	ExpectedReadFileFnCounter int
+
+	ReadDirFn                func(string) ([]fs.FileInfo, error)
+	ReadDirFnCounter         int
+	ExpectedReadDirFnCounter int
+
+	WriteFileFn                func(filename string, data []byte, perm fs.FileMode) error
+	WriteFileFnCounter         int
+	ExpectedWriteFileFnCounter int
+
+	WriteFileAtomicFn                func(filename string, data []byte, perm fs.FileMode) error
+	WriteFileAtomicFnCounter         int
+	ExpectedWriteFileAtomicFnCounter int
+
+	RenameFn                func(oldpath, newpath string) error
+	RenameFnCounter         int
+	ExpectedRenameFnCounter int
+
+	ChmodFn                func(name string, mode fs.FileMode) error
+	ChmodFnCounter         int
+	ExpectedChmodFnCounter int
+
+	EvalSymlinksFn                func(string) (string, error)
+	EvalSymlinksFnCounter         int
+	ExpectedEvalSymlinksFnCounter int
+
+	AvailableBytesFn                func(string) (uint64, error)
+	AvailableBytesFnCounter         int
+	ExpectedAvailableBytesFnCounter int
+}
+
+func (f *FakeIO) Symlink(oldname string, newname string) error {
+	f.SymlinkFnCounter++
+	if f.SymlinkFn != nil {
+		return f.SymlinkFn(oldname, newname)
+	}
+	return nil
+}
+
+func (f *FakeIO) Stat(path string) (os.FileInfo, error) {
+	f.StatFnCounter++
+	if f.StatFn != nil {
+		return f.StatFn(path)
+	}
+	return nil, nil
+}
+
+func (f *FakeIO) Remove(path string) error {
+	f.RemoveFnCounter++
+	if f.RemoveFn != nil {
+		return f.RemoveFn(path)
+	}
+	return nil
+}
+
+func (f *FakeIO) ReadFile(filename string) ([]byte, error) {
+	f.ReadFileFnCounter++
+	if f.ReadFileFn != nil {
+		return f.ReadFileFn(filename)
+	}
+	return nil, nil
+}
+
+func (f *FakeIO) ReadDir(dirname string) ([]fs.FileInfo, error) {
+	f.ReadDirFnCounter++
+	if f.ReadDirFn != nil {
+		return f.ReadDirFn(dirname)
+	}
+	return nil, nil
+}
+
+func (f *FakeIO) WriteFile(filename string, data []byte, perm fs.FileMode) error {
+	f.WriteFileFnCounter++
+	if f.WriteFileFn != nil {
+		return f.WriteFileFn(filename, data, perm)
+	}
+	return nil
+}
+
+func (f *FakeIO) WriteFileAtomic(filename string, data []byte, perm fs.FileMode) error {
+	f.WriteFileAtomicFnCounter++
+	if f.WriteFileAtomicFn != nil {
+		return f.WriteFileAtomicFn(filename, data, perm)
+	}
+	return nil
+}
+
+func (f *FakeIO) Rename(oldpath, newpath string) error {
+	f.RenameFnCounter++
+	if f.RenameFn != nil {
+		return f.RenameFn(oldpath, newpath)
+	}
+	return nil
+}
+
+func (f *FakeIO) Chmod(name string, mode fs.FileMode) error {
+	f.ChmodFnCounter++
+	if f.ChmodFn != nil {
+		return f.ChmodFn(name, mode)
+	}
+	return nil
+}
+
+func (f *FakeIO) EvalSymlinks(path string) (string, error) {
+	f.EvalSymlinksFnCounter++
+	if f.EvalSymlinksFn != nil {
+		return f.EvalSymlinksFn(path)
+	}
+	return path, nil
+}
+
+func (f *FakeIO) AvailableBytes(path string) (uint64, error) {
+	f.AvailableBytesFnCounter++
+	if f.AvailableBytesFn != nil {
+		return f.AvailableBytesFn(path)
+	}
+	return 0, nil
+}
+
+// Validate reports a single aggregated error listing every *Fn whose observed
+// call count doesn't match its Expected*FnCounter.
+func (f *FakeIO) Validate() error {
+	var errs []error
+	if f.SymlinkFnCounter != f.ExpectedSymlinkFnCounter {
+		errs = append(errs, fmt.Errorf("unexpected SymlinkFnCounter %d, expected %d", f.SymlinkFnCounter, f.ExpectedSymlinkFnCounter))
+	}
+	if f.StatFnCounter != f.ExpectedStatFnCounter {
+		errs = append(errs, fmt.Errorf("unexpected StatFnCounter %d, expected %d", f.StatFnCounter, f.ExpectedStatFnCounter))
+	}
+	if f.RemoveFnCounter != f.ExpectedRemoveFnCounter {
+		errs = append(errs, fmt.Errorf("unexpected RemoveFnCounter %d, expected %d", f.RemoveFnCounter, f.ExpectedRemoveFnCounter))
+	}
+	if f.ReadFileFnCounter != f.ExpectedReadFileFnCounter {
+		errs = append(errs, fmt.Errorf("unexpected ReadFileFnCounter %d, expected %d", f.ReadFileFnCounter, f.ExpectedReadFileFnCounter))
+	}
+	if f.ReadDirFnCounter != f.ExpectedReadDirFnCounter {
+		errs = append(errs, fmt.Errorf("unexpected ReadDirFnCounter %d, expected %d", f.ReadDirFnCounter, f.ExpectedReadDirFnCounter))
+	}
+	if f.WriteFileFnCounter != f.ExpectedWriteFileFnCounter {
+		errs = append(errs, fmt.Errorf("unexpected WriteFileFnCounter %d, expected %d", f.WriteFileFnCounter, f.ExpectedWriteFileFnCounter))
+	}
+	if f.WriteFileAtomicFnCounter != f.ExpectedWriteFileAtomicFnCounter {
+		errs = append(errs, fmt.Errorf("unexpected WriteFileAtomicFnCounter %d, expected %d", f.WriteFileAtomicFnCounter, f.ExpectedWriteFileAtomicFnCounter))
+	}
+	if f.RenameFnCounter != f.ExpectedRenameFnCounter {
+		errs = append(errs, fmt.Errorf("unexpected RenameFnCounter %d, expected %d", f.RenameFnCounter, f.ExpectedRenameFnCounter))
+	}
+	if f.ChmodFnCounter != f.ExpectedChmodFnCounter {
+		errs = append(errs, fmt.Errorf("unexpected ChmodFnCounter %d, expected %d", f.ChmodFnCounter, f.ExpectedChmodFnCounter))
+	}
+	if f.EvalSymlinksFnCounter != f.ExpectedEvalSymlinksFnCounter {
+		errs = append(errs, fmt.Errorf("unexpected EvalSymlinksFnCounter %d, expected %d", f.EvalSymlinksFnCounter, f.ExpectedEvalSymlinksFnCounter))
+	}
+	if f.AvailableBytesFnCounter != f.ExpectedAvailableBytesFnCounter {
+		errs = append(errs, fmt.Errorf("unexpected AvailableBytesFnCounter %d, expected %d", f.AvailableBytesFnCounter, f.ExpectedAvailableBytesFnCounter))
+	}
+	return kerrors.NewAggregate(errs)
+}
+
+// FakeFile is a minimal os.FileInfo representing a regular file, useful for
+// stubbing out FakeIO.StatFn/ReadDirFn.
+type FakeFile string
+
+func (f FakeFile) Name() string       { return string(f) }
+func (f FakeFile) Size() int64        { return 0 }
+func (f FakeFile) Mode() fs.FileMode  { return fs.ModeAppend }
+func (f FakeFile) ModTime() time.Time { return time.Unix(0, 0) }
+func (f FakeFile) IsDir() bool        { return false }
+func (f FakeFile) Sys() interface{}   { return nil }
+
+// FakeDir is a minimal os.FileInfo representing a directory, useful for
+// stubbing out FakeIO.StatFn/ReadDirFn.
+type FakeDir string
+
+func (f FakeDir) Name() string       { return string(f) }
+func (f FakeDir) Size() int64        { return 0 }
+func (f FakeDir) Mode() fs.FileMode  { return fs.ModeDir | 0500 }
+func (f FakeDir) ModTime() time.Time { return time.Unix(0, 0) }
+func (f FakeDir) IsDir() bool        { return true }
+func (f FakeDir) Sys() interface{}   { return nil }
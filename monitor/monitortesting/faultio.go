@@ -0,0 +1,194 @@
+package monitortesting
+
+import (
+	"io/fs"
+	"math/rand"
+	"os"
+	"syscall"
+	"time"
+)
+
+// IOBackend mirrors the monitor package's IOInterface. It's declared here, rather than
+// imported, because monitor's own tests import this package to get at FakeIO and friends: an
+// import the other way, of monitor from here, would be a cycle once those test files are
+// compiled in. Go interface satisfaction is structural, so a type implementing IOInterface
+// (including realFS, or an embedder's own backend) can be passed anywhere IOBackend is expected
+// without either package needing to know about the other's interface by name.
+type IOBackend interface {
+	Symlink(oldname string, newname string) error
+	Stat(path string) (os.FileInfo, error)
+	Remove(path string) error
+	ReadFile(filename string) ([]byte, error)
+	ReadDir(dirname string) ([]fs.FileInfo, error)
+	WriteFile(filename string, data []byte, perm fs.FileMode) error
+	WriteFileAtomic(filename string, data []byte, perm fs.FileMode) error
+	Rename(oldpath, newpath string) error
+	Chmod(name string, mode fs.FileMode) error
+	EvalSymlinks(path string) (string, error)
+	AvailableBytes(path string) (uint64, error)
+}
+
+// FaultConfig controls how often, and in what way, FaultInjectingIO misbehaves before
+// delegating to its backend. Each rate is a probability in [0, 1] consulted independently on
+// every call to the corresponding method; leaving a rate at its zero value disables that fault
+// entirely.
+type FaultConfig struct {
+	// ErrorRate is the probability that a call returns a transient I/O error (syscall.EIO)
+	// instead of delegating to the backend, modeling a flaky disk or a busy file.
+	ErrorRate float64
+
+	// NotExistFlapRate is the probability that Stat or ReadFile spuriously reports a path as
+	// missing (os.ErrNotExist) even though the backend would have found it, modeling a
+	// momentarily inconsistent view of the file system, e.g. from an overlay or network
+	// mount.
+	NotExistFlapRate float64
+
+	// PartialWriteRate is the probability that WriteFile only writes a random prefix of data
+	// to the backend before reporting a transient error, modeling a torn write left behind by
+	// a crash or a full disk mid-write. It never silently succeeds: real torn writes are
+	// discovered as I/O errors, not accepted as complete, so callers relying on
+	// retryOnTransientError retry them like any other transient failure.
+	PartialWriteRate float64
+
+	// MaxLatency bounds a random delay injected before every call, modeling a slow disk or a
+	// contended node. Zero disables the delay.
+	MaxLatency time.Duration
+
+	// Rand is the source of randomness consulted for every fault decision. Tests that need
+	// reproducible fault sequences should supply a rand.New(rand.NewSource(seed)); a nil Rand
+	// defaults to one seeded from the current time.
+	Rand *rand.Rand
+}
+
+// FaultInjectingIO wraps a backend implementing the monitor package's file system operations
+// and injects configurable failures per FaultConfig, so tests can assert the monitor reaches a
+// safe state (no panic, no silently accepted corruption, eventual convergence once faults
+// subside) under each failure pattern instead of only ever exercising the happy path.
+type FaultInjectingIO struct {
+	backend IOBackend
+	cfg     FaultConfig
+	rand    *rand.Rand
+}
+
+// NewFaultInjectingIO wraps backend with the faults described by cfg.
+func NewFaultInjectingIO(backend IOBackend, cfg FaultConfig) *FaultInjectingIO {
+	r := cfg.Rand
+	if r == nil {
+		r = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+	return &FaultInjectingIO{backend: backend, cfg: cfg, rand: r}
+}
+
+// chance reports whether an event with the given probability should fire on this call.
+func (f *FaultInjectingIO) chance(probability float64) bool {
+	return probability > 0 && f.rand.Float64() < probability
+}
+
+// delay sleeps for a random duration up to cfg.MaxLatency, if configured.
+func (f *FaultInjectingIO) delay() {
+	if f.cfg.MaxLatency > 0 {
+		time.Sleep(time.Duration(f.rand.Int63n(int64(f.cfg.MaxLatency) + 1)))
+	}
+}
+
+func (f *FaultInjectingIO) Symlink(oldname string, newname string) error {
+	f.delay()
+	if f.chance(f.cfg.ErrorRate) {
+		return syscall.EIO
+	}
+	return f.backend.Symlink(oldname, newname)
+}
+
+func (f *FaultInjectingIO) Stat(path string) (os.FileInfo, error) {
+	f.delay()
+	if f.chance(f.cfg.ErrorRate) {
+		return nil, syscall.EIO
+	}
+	if f.chance(f.cfg.NotExistFlapRate) {
+		return nil, os.ErrNotExist
+	}
+	return f.backend.Stat(path)
+}
+
+func (f *FaultInjectingIO) Remove(path string) error {
+	f.delay()
+	if f.chance(f.cfg.ErrorRate) {
+		return syscall.EIO
+	}
+	return f.backend.Remove(path)
+}
+
+func (f *FaultInjectingIO) ReadFile(filename string) ([]byte, error) {
+	f.delay()
+	if f.chance(f.cfg.ErrorRate) {
+		return nil, syscall.EIO
+	}
+	if f.chance(f.cfg.NotExistFlapRate) {
+		return nil, os.ErrNotExist
+	}
+	return f.backend.ReadFile(filename)
+}
+
+func (f *FaultInjectingIO) ReadDir(dirname string) ([]fs.FileInfo, error) {
+	f.delay()
+	if f.chance(f.cfg.ErrorRate) {
+		return nil, syscall.EIO
+	}
+	return f.backend.ReadDir(dirname)
+}
+
+func (f *FaultInjectingIO) WriteFile(filename string, data []byte, perm fs.FileMode) error {
+	f.delay()
+	if f.chance(f.cfg.PartialWriteRate) {
+		truncated := data[:f.rand.Intn(len(data)+1)]
+		_ = f.backend.WriteFile(filename, truncated, perm)
+		return syscall.EIO
+	}
+	if f.chance(f.cfg.ErrorRate) {
+		return syscall.EIO
+	}
+	return f.backend.WriteFile(filename, data, perm)
+}
+
+func (f *FaultInjectingIO) WriteFileAtomic(filename string, data []byte, perm fs.FileMode) error {
+	f.delay()
+	if f.chance(f.cfg.PartialWriteRate) {
+		return syscall.EIO
+	}
+	if f.chance(f.cfg.ErrorRate) {
+		return syscall.EIO
+	}
+	return f.backend.WriteFileAtomic(filename, data, perm)
+}
+
+func (f *FaultInjectingIO) Rename(oldpath, newpath string) error {
+	f.delay()
+	if f.chance(f.cfg.ErrorRate) {
+		return syscall.EIO
+	}
+	return f.backend.Rename(oldpath, newpath)
+}
+
+func (f *FaultInjectingIO) Chmod(name string, mode fs.FileMode) error {
+	f.delay()
+	if f.chance(f.cfg.ErrorRate) {
+		return syscall.EIO
+	}
+	return f.backend.Chmod(name, mode)
+}
+
+func (f *FaultInjectingIO) EvalSymlinks(path string) (string, error) {
+	f.delay()
+	if f.chance(f.cfg.ErrorRate) {
+		return "", syscall.EIO
+	}
+	return f.backend.EvalSymlinks(path)
+}
+
+func (f *FaultInjectingIO) AvailableBytes(path string) (uint64, error) {
+	f.delay()
+	if f.chance(f.cfg.ErrorRate) {
+		return 0, syscall.EIO
+	}
+	return f.backend.AvailableBytes(path)
+}
@@ -0,0 +1,328 @@
+package monitortesting
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"sync"
+	"time"
+)
+
+// fileInfoSnapshot is a serializable snapshot of an os.FileInfo, since the interface itself
+// can't round-trip through JSON, and implements os.FileInfo itself so ReplayIO can hand one
+// back to a caller.
+type fileInfoSnapshot struct {
+	NameField    string      `json:"name"`
+	SizeField    int64       `json:"size"`
+	ModeField    fs.FileMode `json:"mode"`
+	ModTimeField time.Time   `json:"modTime"`
+	IsDirField   bool        `json:"isDir"`
+}
+
+func newFileInfoSnapshot(info os.FileInfo) *fileInfoSnapshot {
+	if info == nil {
+		return nil
+	}
+	return &fileInfoSnapshot{
+		NameField:    info.Name(),
+		SizeField:    info.Size(),
+		ModeField:    info.Mode(),
+		ModTimeField: info.ModTime(),
+		IsDirField:   info.IsDir(),
+	}
+}
+
+func (f *fileInfoSnapshot) Name() string       { return f.NameField }
+func (f *fileInfoSnapshot) Size() int64        { return f.SizeField }
+func (f *fileInfoSnapshot) Mode() fs.FileMode  { return f.ModeField }
+func (f *fileInfoSnapshot) ModTime() time.Time { return f.ModTimeField }
+func (f *fileInfoSnapshot) IsDir() bool        { return f.IsDirField }
+func (f *fileInfoSnapshot) Sys() interface{}   { return nil }
+
+// recordedCall is one recorded IO operation and its result, serialized as a single JSON line
+// in a trace file produced by RecordingIO and consumed by ReplayIO.
+type recordedCall struct {
+	Op   string   `json:"op"`
+	Args []string `json:"args,omitempty"`
+
+	FileInfo  *fileInfoSnapshot  `json:"fileInfo,omitempty"`  // Stat
+	FileInfos []fileInfoSnapshot `json:"fileInfos,omitempty"` // ReadDir
+	Data      []byte             `json:"data,omitempty"`      // ReadFile
+	Bytes     uint64             `json:"bytes,omitempty"`     // AvailableBytes
+
+	Err string `json:"err,omitempty"`
+}
+
+// errNotExistMarker is the recorded Err value standing in for os.ErrNotExist, so ReplayIO can
+// hand back an error that os.IsNotExist still recognizes instead of an opaque string error.
+const errNotExistMarker = "not exist"
+
+func encodeErr(err error) string {
+	if err == nil {
+		return ""
+	}
+	if os.IsNotExist(err) {
+		return errNotExistMarker
+	}
+	return err.Error()
+}
+
+func decodeErr(s string) error {
+	switch s {
+	case "":
+		return nil
+	case errNotExistMarker:
+		return os.ErrNotExist
+	default:
+		return fmt.Errorf("%s", s)
+	}
+}
+
+// RecordingIO wraps a backend implementing the monitor package's file system operations and
+// appends every call and its result to a trace, one JSON object per line, so a field-reported
+// fallback failure can be reproduced later: run the monitor once against the backend that
+// triggered it wrapped in a RecordingIO, then replay the resulting trace with ReplayIO in a
+// regression test, without needing the original file system state.
+type RecordingIO struct {
+	backend IOBackend
+	mu      sync.Mutex
+	enc     *json.Encoder
+}
+
+// NewRecordingIO wraps backend, appending a JSON-lines trace of every call to trace.
+func NewRecordingIO(backend IOBackend, trace io.Writer) *RecordingIO {
+	return &RecordingIO{backend: backend, enc: json.NewEncoder(trace)}
+}
+
+func (r *RecordingIO) record(call recordedCall) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	// A trace is a debugging aid, not load-bearing state: a failure to append to it must never
+	// surface as a file system error to the monitor being observed.
+	_ = r.enc.Encode(call)
+}
+
+func (r *RecordingIO) Symlink(oldname string, newname string) error {
+	err := r.backend.Symlink(oldname, newname)
+	r.record(recordedCall{Op: "Symlink", Args: []string{oldname, newname}, Err: encodeErr(err)})
+	return err
+}
+
+func (r *RecordingIO) Stat(path string) (os.FileInfo, error) {
+	info, err := r.backend.Stat(path)
+	r.record(recordedCall{Op: "Stat", Args: []string{path}, FileInfo: newFileInfoSnapshot(info), Err: encodeErr(err)})
+	return info, err
+}
+
+func (r *RecordingIO) Remove(path string) error {
+	err := r.backend.Remove(path)
+	r.record(recordedCall{Op: "Remove", Args: []string{path}, Err: encodeErr(err)})
+	return err
+}
+
+func (r *RecordingIO) ReadFile(filename string) ([]byte, error) {
+	data, err := r.backend.ReadFile(filename)
+	r.record(recordedCall{Op: "ReadFile", Args: []string{filename}, Data: data, Err: encodeErr(err)})
+	return data, err
+}
+
+func (r *RecordingIO) ReadDir(dirname string) ([]fs.FileInfo, error) {
+	infos, err := r.backend.ReadDir(dirname)
+	snapshots := make([]fileInfoSnapshot, 0, len(infos))
+	for _, info := range infos {
+		snapshots = append(snapshots, *newFileInfoSnapshot(info))
+	}
+	r.record(recordedCall{Op: "ReadDir", Args: []string{dirname}, FileInfos: snapshots, Err: encodeErr(err)})
+	return infos, err
+}
+
+func (r *RecordingIO) WriteFile(filename string, data []byte, perm fs.FileMode) error {
+	err := r.backend.WriteFile(filename, data, perm)
+	r.record(recordedCall{Op: "WriteFile", Args: []string{filename, perm.String()}, Data: data, Err: encodeErr(err)})
+	return err
+}
+
+func (r *RecordingIO) WriteFileAtomic(filename string, data []byte, perm fs.FileMode) error {
+	err := r.backend.WriteFileAtomic(filename, data, perm)
+	r.record(recordedCall{Op: "WriteFileAtomic", Args: []string{filename, perm.String()}, Data: data, Err: encodeErr(err)})
+	return err
+}
+
+func (r *RecordingIO) Rename(oldpath, newpath string) error {
+	err := r.backend.Rename(oldpath, newpath)
+	r.record(recordedCall{Op: "Rename", Args: []string{oldpath, newpath}, Err: encodeErr(err)})
+	return err
+}
+
+func (r *RecordingIO) Chmod(name string, mode fs.FileMode) error {
+	err := r.backend.Chmod(name, mode)
+	r.record(recordedCall{Op: "Chmod", Args: []string{name, mode.String()}, Err: encodeErr(err)})
+	return err
+}
+
+func (r *RecordingIO) EvalSymlinks(path string) (string, error) {
+	resolved, err := r.backend.EvalSymlinks(path)
+	r.record(recordedCall{Op: "EvalSymlinks", Args: []string{path, resolved}, Err: encodeErr(err)})
+	return resolved, err
+}
+
+func (r *RecordingIO) AvailableBytes(path string) (uint64, error) {
+	bytes, err := r.backend.AvailableBytes(path)
+	r.record(recordedCall{Op: "AvailableBytes", Args: []string{path}, Bytes: bytes, Err: encodeErr(err)})
+	return bytes, err
+}
+
+// ReplayIO re-executes a trace recorded by RecordingIO: each call returns the next recorded
+// result for the same operation, in order, instead of touching any real backend. This lets a
+// regression test reproduce the exact sequence of results a field-reported failure saw,
+// including transient errors and stale reads, without depending on the file system state that
+// produced them.
+type ReplayIO struct {
+	mu    sync.Mutex
+	calls []recordedCall
+	next  int
+}
+
+// NewReplayIO parses a JSON-lines trace produced by RecordingIO.
+func NewReplayIO(trace io.Reader) (*ReplayIO, error) {
+	var calls []recordedCall
+	scanner := bufio.NewScanner(trace)
+	// Traces can carry large ReadFile/WriteFile payloads; grow past bufio.Scanner's default
+	// 64KiB line limit instead of silently truncating.
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		var call recordedCall
+		if err := json.Unmarshal(line, &call); err != nil {
+			return nil, fmt.Errorf("failed to parse trace line: %v", err)
+		}
+		calls = append(calls, call)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read trace: %v", err)
+	}
+	return &ReplayIO{calls: calls}, nil
+}
+
+// next dequeues the next recorded call, failing loudly if op doesn't match or the trace is
+// exhausted, so a diverging code path is caught immediately instead of silently replaying the
+// wrong result.
+func (r *ReplayIO) nextCall(op string) (recordedCall, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.next >= len(r.calls) {
+		return recordedCall{}, fmt.Errorf("replay: trace exhausted, but %s was called", op)
+	}
+	call := r.calls[r.next]
+	r.next++
+	if call.Op != op {
+		return recordedCall{}, fmt.Errorf("replay: trace expected %s, but %s was called", call.Op, op)
+	}
+	return call, nil
+}
+
+func (r *ReplayIO) Symlink(oldname string, newname string) error {
+	call, err := r.nextCall("Symlink")
+	if err != nil {
+		return err
+	}
+	return decodeErr(call.Err)
+}
+
+func (r *ReplayIO) Stat(path string) (os.FileInfo, error) {
+	call, err := r.nextCall("Stat")
+	if err != nil {
+		return nil, err
+	}
+	if call.FileInfo == nil {
+		return nil, decodeErr(call.Err)
+	}
+	return call.FileInfo, decodeErr(call.Err)
+}
+
+func (r *ReplayIO) Remove(path string) error {
+	call, err := r.nextCall("Remove")
+	if err != nil {
+		return err
+	}
+	return decodeErr(call.Err)
+}
+
+func (r *ReplayIO) ReadFile(filename string) ([]byte, error) {
+	call, err := r.nextCall("ReadFile")
+	if err != nil {
+		return nil, err
+	}
+	return call.Data, decodeErr(call.Err)
+}
+
+func (r *ReplayIO) ReadDir(dirname string) ([]fs.FileInfo, error) {
+	call, err := r.nextCall("ReadDir")
+	if err != nil {
+		return nil, err
+	}
+	infos := make([]fs.FileInfo, len(call.FileInfos))
+	for i := range call.FileInfos {
+		snapshot := call.FileInfos[i]
+		infos[i] = &snapshot
+	}
+	return infos, decodeErr(call.Err)
+}
+
+func (r *ReplayIO) WriteFile(filename string, data []byte, perm fs.FileMode) error {
+	call, err := r.nextCall("WriteFile")
+	if err != nil {
+		return err
+	}
+	return decodeErr(call.Err)
+}
+
+func (r *ReplayIO) WriteFileAtomic(filename string, data []byte, perm fs.FileMode) error {
+	call, err := r.nextCall("WriteFileAtomic")
+	if err != nil {
+		return err
+	}
+	return decodeErr(call.Err)
+}
+
+func (r *ReplayIO) Rename(oldpath, newpath string) error {
+	call, err := r.nextCall("Rename")
+	if err != nil {
+		return err
+	}
+	return decodeErr(call.Err)
+}
+
+func (r *ReplayIO) Chmod(name string, mode fs.FileMode) error {
+	call, err := r.nextCall("Chmod")
+	if err != nil {
+		return err
+	}
+	return decodeErr(call.Err)
+}
+
+func (r *ReplayIO) EvalSymlinks(path string) (string, error) {
+	call, err := r.nextCall("EvalSymlinks")
+	if err != nil {
+		return "", err
+	}
+	resolved := path
+	if len(call.Args) > 1 {
+		resolved = call.Args[1]
+	}
+	return resolved, decodeErr(call.Err)
+}
+
+func (r *ReplayIO) AvailableBytes(path string) (uint64, error) {
+	call, err := r.nextCall("AvailableBytes")
+	if err != nil {
+		return 0, err
+	}
+	return call.Bytes, decodeErr(call.Err)
+}
@@ -0,0 +1,57 @@
+package monitor
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// cpuPressureFile is the kernel's PSI (Pressure Stall Information) file for CPU pressure.
+// PSI is optional kernel functionality (CONFIG_PSI); not every node will have it.
+const cpuPressureFile = "/proc/pressure/cpu"
+
+// cpuPressureThreshold is the "some avg10" percentage from cpuPressureFile above which the
+// node is considered heavily loaded enough that operand slowness is more likely explained by
+// resource contention than by the operand itself.
+const cpuPressureThreshold = 50.0
+
+// isNodeUnderPressure reports whether this node's CPU pressure is high enough to justify
+// extending the fallback deadline instead of charging the slowness against the operand,
+// reducing spurious rollbacks on slow or overcommitted hardware. When cpuPressureFile doesn't
+// exist (PSI disabled or unsupported), it reports false so the fallback countdown behaves
+// exactly as before.
+func (sm *StartupMonitor) isNodeUnderPressure() (bool, error) {
+	raw, err := sm.io.ReadFile(cpuPressureFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	avg10, err := cpuPressureSomeAvg10(raw)
+	if err != nil {
+		return false, err
+	}
+	return avg10 >= cpuPressureThreshold, nil
+}
+
+// cpuPressureSomeAvg10 extracts the avg10 field off the "some" line of a PSI pressure file,
+// e.g. "some avg10=12.34 avg60=3.21 avg300=1.11 total=98765" -> 12.34.
+func cpuPressureSomeAvg10(raw []byte) (float64, error) {
+	const avg10Prefix = "avg10="
+
+	for _, line := range strings.Split(string(raw), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || fields[0] != "some" {
+			continue
+		}
+		for _, field := range fields[1:] {
+			if strings.HasPrefix(field, avg10Prefix) {
+				return strconv.ParseFloat(strings.TrimPrefix(field, avg10Prefix), 64)
+			}
+		}
+	}
+	return 0, fmt.Errorf("no \"some\" line with an avg10 field found in %s", cpuPressureFile)
+}
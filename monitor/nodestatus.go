@@ -0,0 +1,85 @@
+package monitor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// NodeState is the coarse-grained state startup-monitor reports for its target via a Node
+// annotation, for a cluster operator that wants to surface a Degraded condition from Node
+// objects alone, without wiring up its own dynamic client and operator resource (see
+// WithOperatorResource).
+type NodeState string
+
+const (
+	// NodeStateMonitoring means the monitor is still assessing the target's health.
+	NodeStateMonitoring NodeState = "Monitoring"
+	// NodeStateFallbackPerformed means the monitor fell back to the previous revision.
+	NodeStateFallbackPerformed NodeState = "FallbackPerformed"
+	// NodeStateSucceeded means the target was observed healthy and promoted to the last known
+	// good revision.
+	NodeStateSucceeded NodeState = "Succeeded"
+)
+
+// nodeStateAnnotation is the JSON blob written into the node annotation nodeStateAnnotationKey
+// returns, describing sm's current state for its target.
+type nodeStateAnnotation struct {
+	State              NodeState `json:"state"`
+	Revision           int       `json:"revision"`
+	Message            string    `json:"message,omitempty"`
+	LastTransitionTime string    `json:"lastTransitionTime"`
+}
+
+// nodeStateAnnotationKey returns the node annotation key startup-monitor patches to report its
+// state, namespaced per target so several monitors on the same node don't collide.
+func (sm *StartupMonitor) nodeStateAnnotationKey() string {
+	return fmt.Sprintf("startup-monitor.static-pods.openshift.io/%s-state", sm.targetName)
+}
+
+// patchNodeState patches this node's object with a JSON-encoded nodeStateAnnotation describing
+// state, so a cluster operator can surface a Degraded condition from Node annotations alone,
+// without needing its own dynamic client and operator resource configured (compare
+// patchOperatorCondition). This is a best-effort, additional signal: it is skipped entirely
+// when node state reporting hasn't been enabled via WithNodeStateReporting, or when no client
+// is configured.
+func (sm *StartupMonitor) patchNodeState(ctx context.Context, revision int, state NodeState, message string) error {
+	if !sm.reportNodeState || !sm.hasClient() {
+		return nil
+	}
+
+	nodeName, err := nodeName()
+	if err != nil {
+		return fmt.Errorf("failed to determine the node name: %v", err)
+	}
+
+	encodedState, err := json.Marshal(nodeStateAnnotation{
+		State:              state,
+		Revision:           revision,
+		Message:            message,
+		LastTransitionTime: sm.clock.Now().UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode the %s node state: %v", sm.targetName, err)
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{
+				sm.nodeStateAnnotationKey(): string(encodedState),
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode the node patch for %s: %v", sm.targetName, err)
+	}
+
+	return retryOnAPIServerError(func() error {
+		_, err := sm.client.CoreV1().Nodes().Patch(ctx, nodeName, types.MergePatchType, patch, metav1.PatchOptions{})
+		return err
+	})
+}
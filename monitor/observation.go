@@ -0,0 +1,44 @@
+package monitor
+
+import (
+	"fmt"
+	"path"
+)
+
+// observationModeHistoryPath returns the path of the durable ledger recording which revisions
+// have already counted against observationModeRevisions, so the count survives the monitor
+// being restarted fresh for every revision it guards.
+func (sm *StartupMonitor) observationModeHistoryPath() string {
+	return path.Join(sm.staticPodResourcesPath, fmt.Sprintf("%s-observation-mode-history", sm.targetName))
+}
+
+// observationModeActive reports whether the current revision still falls within
+// observationModeRevisions, recording it into the durable history the first time it's seen.
+// Once observationModeRevisions distinct revisions have been recorded, later ones fall back
+// for real.
+func (sm *StartupMonitor) observationModeActive() (bool, error) {
+	if sm.observationModeRevisions <= 0 {
+		return false, nil
+	}
+
+	history, err := sm.readRevisionLedger(sm.observationModeHistoryPath())
+	if err != nil {
+		return false, err
+	}
+
+	found := false
+	for _, revision := range history {
+		if revision == sm.revision {
+			found = true
+			break
+		}
+	}
+	if !found {
+		history = append(history, sm.revision)
+		if err := sm.writeRevisionLedger(sm.observationModeHistoryPath(), history); err != nil {
+			return false, err
+		}
+	}
+
+	return len(history) <= sm.observationModeRevisions, nil
+}
@@ -0,0 +1,102 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// FallbackConditionType is the condition reported on the operator.openshift.io resource when
+// the startup monitor falls back to a previous revision. It is exported so monitoring rules
+// and operator code can match on it reliably across versions.
+const FallbackConditionType = "StartupMonitorFallbackDegraded"
+
+// FallbackBlockedConditionType is the condition reported on the operator.openshift.io
+// resource when a FallbackGuardFunc vetoes a fallback that was otherwise warranted. It is
+// exported so monitoring rules and operator code can match on it reliably across versions.
+const FallbackBlockedConditionType = "StartupMonitorFallbackBlockedDegraded"
+
+// FallbackPreflightFailedConditionType is the condition reported on the operator.openshift.io
+// resource when the manifests filesystem fails the pre-flight free-space or writability check
+// that runs before a fallback. It is exported so monitoring rules and operator code can match
+// on it reliably across versions.
+const FallbackPreflightFailedConditionType = "StartupMonitorFallbackPreflightFailedDegraded"
+
+// FallbackObservedConditionType is the condition reported on the operator.openshift.io
+// resource when the monitor is in observation-only mode (see StartupMonitor.WithObservationMode)
+// and would otherwise have fallen back to the previous revision. Unlike the other condition
+// types here it does not carry a "Degraded" suffix: observation mode behaving exactly as
+// configured is not itself a degraded cluster state. It is exported so monitoring rules and
+// operator code can match on it reliably across versions.
+const FallbackObservedConditionType = "StartupMonitorFallbackObserved"
+
+// clusterOperatorResourceName is the name of the singleton operator.openshift.io resource
+// (e.g. kubeapiservers.operator.openshift.io/cluster).
+const clusterOperatorResourceName = "cluster"
+
+// patchOperatorFallbackCondition patches sm.operatorResource, a singleton
+// operator.openshift.io resource (e.g. kubeapiservers.operator.openshift.io/cluster), with a
+// StartupMonitorFallbackDegraded=True condition, so the cluster operator goes Degraded
+// visibly. This is a best-effort, additional signal: it is skipped entirely when no dynamic
+// client or operator resource has been configured.
+func (sm *StartupMonitor) patchOperatorFallbackCondition(ctx context.Context, fromRevision, toRevision int, reason FallbackReason, diagnostics string) error {
+	nodeName, err := nodeName()
+	if err != nil {
+		return fmt.Errorf("failed to determine the node name: %v", err)
+	}
+
+	message := fmt.Sprintf("node %q fell back %s from revision %d to revision %d", nodeName, sm.targetName, fromRevision, toRevision)
+	if len(diagnostics) > 0 {
+		message += fmt.Sprintf(": %s", diagnostics)
+	}
+	return sm.patchOperatorCondition(ctx, FallbackConditionType, string(reason), message)
+}
+
+// patchOperatorCondition patches sm.operatorResource, a singleton operator.openshift.io
+// resource (e.g. kubeapiservers.operator.openshift.io/cluster), setting conditionType=True
+// with the given reason and message. This is a best-effort, additional signal: it is
+// skipped entirely when no dynamic client or operator resource has been configured.
+func (sm *StartupMonitor) patchOperatorCondition(ctx context.Context, conditionType, reason, message string) error {
+	if !sm.hasOperatorResource() {
+		return nil
+	}
+
+	client := sm.dynamicClient.Resource(sm.operatorResource)
+	operator, err := client.Get(ctx, clusterOperatorResourceName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get %s/%s to report the %s condition: %v", sm.operatorResource.Resource, clusterOperatorResourceName, conditionType, err)
+	}
+
+	condition := map[string]interface{}{
+		"type":               conditionType,
+		"status":             "True",
+		"reason":             reason,
+		"message":            message,
+		"lastTransitionTime": time.Now().UTC().Format(time.RFC3339),
+	}
+
+	conditions, _, err := unstructured.NestedSlice(operator.Object, "status", "conditions")
+	if err != nil {
+		return fmt.Errorf("failed to read existing conditions on %s/%s: %v", sm.operatorResource.Resource, clusterOperatorResourceName, err)
+	}
+	replaced := false
+	for i, existing := range conditions {
+		if existingCondition, ok := existing.(map[string]interface{}); ok && existingCondition["type"] == conditionType {
+			conditions[i] = condition
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		conditions = append(conditions, condition)
+	}
+	if err := unstructured.SetNestedSlice(operator.Object, conditions, "status", "conditions"); err != nil {
+		return fmt.Errorf("failed to set the %s condition on %s/%s: %v", conditionType, sm.operatorResource.Resource, clusterOperatorResourceName, err)
+	}
+
+	_, err = client.UpdateStatus(ctx, operator, metav1.UpdateOptions{})
+	return err
+}
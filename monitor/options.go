@@ -1,6 +1,13 @@
 package monitor
 
-import "time"
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/clock"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+)
 
 // WithProbeTimeout specifies a timeout after which the monitor starts the fall back procedure
 func (sm *StartupMonitor) WithProbeTimeout(timeout time.Duration) *StartupMonitor {
@@ -14,3 +21,277 @@ func (sm *StartupMonitor) WithProbeInterval(probeInterval time.Duration) *Startu
 	sm.probeInterval = probeInterval
 	return sm
 }
+
+// WithFallbackMetadata registers a function invoked at fallback time to compute extra
+// annotations/labels applied to the fallback pod, beyond the built-in
+// fallback-for-revision annotation. It may be called multiple times to register
+// several functions; they are applied in registration order.
+func (sm *StartupMonitor) WithFallbackMetadata(fn FallbackMetadataFunc) *StartupMonitor {
+	sm.fallbackMetadataFuncs = append(sm.fallbackMetadataFuncs, fn)
+	return sm
+}
+
+// WithFallbackGuard registers a function consulted before falling back to the previous
+// revision. Any guard that vetoes the fallback aborts the procedure and reports a degraded
+// status instead of touching the on-disk manifests. It may be called multiple times to
+// register several guards; they are consulted in registration order and the first veto wins.
+func (sm *StartupMonitor) WithFallbackGuard(fn FallbackGuardFunc) *StartupMonitor {
+	sm.fallbackGuards = append(sm.fallbackGuards, fn)
+	return sm
+}
+
+// WithSleepMode configures the monitor, once the target is observed healthy, to idle in a
+// low-cost sleep state instead of removing its own manifest: the last known good revision is
+// still recorded as usual, but the monitor's pod is left running with health probing stopped,
+// so its logs and status endpoint remain available for inspection for the rest of the
+// revision's lifetime, until superseded by a new revision like normal.
+func (sm *StartupMonitor) WithSleepMode() *StartupMonitor {
+	sm.sleepInsteadOfSelfRemoval = true
+	return sm
+}
+
+// WithLastKnownGoodHistorySize sets how many proven-good revisions the monitor keeps a
+// pointer for, beyond the current one. A fallback that finds the current pointer missing or
+// its revision blacklisted then has older, already-vetted pointers to try before falling back
+// to scanning the resource directory for any older revision. Must be at least 1; the default,
+// 1, keeps only the current pointer, matching the monitor's original behavior.
+func (sm *StartupMonitor) WithLastKnownGoodHistorySize(size int) *StartupMonitor {
+	sm.lastKnownGoodHistorySize = size
+	return sm
+}
+
+// WithKnownGoodSnapshotRetention enables the known-good snapshot manager and sets how many
+// revisions it keeps an archived manifest, and its manifest-declared dependent files (see
+// dependentFilesAnnotation), for. Unlike WithLastKnownGoodHistorySize, which points at
+// manifests a Layout already keeps around, this archive is self-contained and
+// layout-independent. Defaults to 0, disabled.
+func (sm *StartupMonitor) WithKnownGoodSnapshotRetention(retention int) *StartupMonitor {
+	sm.knownGoodSnapshotRetention = retention
+	return sm
+}
+
+// WithDependentFileVerification enables checking, at fallback time, that every dependent file
+// the revision being restored declares via dependentFilesAnnotation is actually present on
+// disk, repairing it from the known-good snapshot archive (see WithKnownGoodSnapshotRetention)
+// when it is missing and an archived copy is available. Without it, a fallback may write a pod
+// manifest that references a configmap or secret file an installer failed to leave behind for
+// that revision.
+func (sm *StartupMonitor) WithDependentFileVerification() *StartupMonitor {
+	sm.verifyDependentFiles = true
+	return sm
+}
+
+// WithObservationMode makes performFallback run its full detection pipeline, including
+// diagnostics and the failed-verdict marker, without actually touching the on-disk manifests
+// for the first revisions revisions the monitor guards, reporting
+// FallbackObservedConditionType instead of really falling back. It de-risks adopting the
+// monitor on a production control plane by surfacing what it would have done before trusting
+// it to act. Defaults to 0, disabled.
+func (sm *StartupMonitor) WithObservationMode(revisions int) *StartupMonitor {
+	sm.observationModeRevisions = revisions
+	return sm
+}
+
+// WithInstallerLockFilePath enables coordinating with a concurrent installer pod: sync
+// acquires an exclusive flock on path before reading the root manifest or performing a
+// fallback, and releases it before returning, so the monitor never races an installer that is
+// in the middle of writing a new revision. path doesn't need to exist beforehand; it is created
+// on first use. Left unset, the default, no locking is performed.
+func (sm *StartupMonitor) WithInstallerLockFilePath(path string) *StartupMonitor {
+	sm.installerLock = NewFLock(path)
+	return sm
+}
+
+// WithInstallerLockTimeout overrides how long sync waits to acquire the lock set via
+// WithInstallerLockFilePath before giving up and returning a SyncErrorCategoryInstallerLock
+// error for that tick. Only meaningful once WithInstallerLockFilePath is also set.
+func (sm *StartupMonitor) WithInstallerLockTimeout(timeout time.Duration) *StartupMonitor {
+	sm.installerLockTimeout = timeout
+	return sm
+}
+
+// WithLayout overrides the directory scheme used to locate root and revisioned manifests.
+// It defaults to the OpenShift layout; only alternative node tooling should need to set it.
+func (sm *StartupMonitor) WithLayout(layout Layout) *StartupMonitor {
+	sm.layout = layout
+	return sm
+}
+
+// WithTargetNamespace sets the namespace the operand's static pod runs in. It is only
+// required when a client is configured via WithClient.
+func (sm *StartupMonitor) WithTargetNamespace(namespace string) *StartupMonitor {
+	sm.targetNamespace = namespace
+	return sm
+}
+
+// WithClient sets the optional Kubernetes client used to unlock API-backed features
+// (events, status updates, node checks). The monitor remains fully functional without
+// one, falling back to reading and writing local static pod manifests only.
+func (sm *StartupMonitor) WithClient(client kubernetes.Interface) *StartupMonitor {
+	sm.client = client
+	return sm
+}
+
+// WithDynamicClient sets the optional dynamic client used to patch the operator resource
+// with a fallback condition.
+func (sm *StartupMonitor) WithDynamicClient(client dynamic.Interface) *StartupMonitor {
+	sm.dynamicClient = client
+	return sm
+}
+
+// WithOperatorResource sets the operator.openshift.io resource (e.g.
+// kubeapiservers.operator.openshift.io) to patch with a fallback condition.
+func (sm *StartupMonitor) WithOperatorResource(resource schema.GroupVersionResource) *StartupMonitor {
+	sm.operatorResource = resource
+	return sm
+}
+
+// WithServingCertificate enables validating the target's serving certificate/key pair on disk
+// as an additional health signal alongside the caller-provided health check: the target is
+// only considered healthy if the certificate also parses, matches its key, and is within its
+// validity window.
+func (sm *StartupMonitor) WithServingCertificate(certFile, keyFile string) *StartupMonitor {
+	sm.servingCertFile = certFile
+	sm.servingKeyFile = keyFile
+	return sm
+}
+
+// WithCRISocket enables fetching exit codes and termination reasons for the target's
+// containers via the CRI at fallback time, so the fallback diagnostics can distinguish e.g. an
+// OOM kill from a config-parse crash instead of just reporting a bare timeout.
+func (sm *StartupMonitor) WithCRISocket(socket string) *StartupMonitor {
+	sm.criSocket = socket
+	return sm
+}
+
+// WithBootstrapAPIServerEndpoint sets the address of the temporary bootstrap apiserver used
+// during cluster installation, checked alongside the other masters' apiservers when deciding
+// whether to fall back: if it is reachable, the control plane as a whole is up and the local
+// revision is more likely to blame.
+func (sm *StartupMonitor) WithBootstrapAPIServerEndpoint(endpoint string) *StartupMonitor {
+	sm.bootstrapAPIServerEndpoint = endpoint
+	return sm
+}
+
+// WithMaxRolloutExtensions overrides how many times a timed-out deadline may be extended
+// while the cluster-wide rollout is still Progressing.
+func (sm *StartupMonitor) WithMaxRolloutExtensions(max int) *StartupMonitor {
+	sm.maxRolloutExtensions = max
+	return sm
+}
+
+// WithSuccessThreshold sets how many consecutive healthy probes are required before the target
+// is declared healthy, so a single flaky 200 doesn't immediately delete the monitor. Must be at
+// least 1; the default, 1, matches the monitor's original behavior.
+func (sm *StartupMonitor) WithSuccessThreshold(n int) *StartupMonitor {
+	sm.successThreshold = n
+	return sm
+}
+
+// WithFailureThreshold sets how many consecutive unhealthy probes are required before a
+// timed-out deadline actually triggers a fallback, so a single transient failure landing right
+// on the deadline doesn't roll back a target that's otherwise been healthy. Must be at least 1;
+// the default, 1, matches the monitor's original behavior.
+func (sm *StartupMonitor) WithFailureThreshold(n int) *StartupMonitor {
+	sm.failureThreshold = n
+	return sm
+}
+
+// WithNodeStateReporting enables patching this node's object with a JSON-encoded NodeState
+// annotation (see patchNodeState) as the monitor's assessment of the target changes, so a
+// cluster operator can surface a Degraded condition from Node objects alone, without wiring up
+// a dynamic client and an operator resource via WithDynamicClient/WithOperatorResource.
+// Requires WithClient to also be set; without a client, this is a no-op.
+func (sm *StartupMonitor) WithNodeStateReporting() *StartupMonitor {
+	sm.reportNodeState = true
+	return sm
+}
+
+// WithStateFileDir enables writing a machine-readable JSON state file describing the monitor's
+// current state for its target into dir on every phase transition, so the installer and pruner
+// can decide whether a revision rollout failed without depending on a Kubernetes client. dir
+// must already exist. Left unset, the default, no file is written.
+func (sm *StartupMonitor) WithStateFileDir(dir string) *StartupMonitor {
+	sm.stateFileDir = dir
+	return sm
+}
+
+// WithAnnotationPrefix overrides the annotation domain prefix applied to the fallback pod's
+// manifest, e.g. "fallback-for-revision" becomes "<prefix>/fallback-for-revision". Left unset,
+// the default, defaultAnnotationPrefix is used.
+func (sm *StartupMonitor) WithAnnotationPrefix(prefix string) *StartupMonitor {
+	sm.annotationPrefix = prefix
+	return sm
+}
+
+// WithTerminationMessagePath enables writing a concise summary of a fatal watchdog exit to
+// path, so `kubectl describe pod` surfaces why the monitor's pod terminated without needing log
+// access. Left unset, the default, no termination message is written.
+func (sm *StartupMonitor) WithTerminationMessagePath(path string) *StartupMonitor {
+	sm.terminationMessagePath = path
+	return sm
+}
+
+// WithPinnedFallbackRevision overrides fallbackToPreviousRevision's usual last-known-good/N-1
+// heuristic and forces it to fall back to exactly revision instead, so an admin who already
+// knows which revision was last good during incident response doesn't have to trust
+// findPreviousRevision to land on it. revision must have a manifest on disk;
+// fallbackToPreviousRevision returns an error otherwise. Left unset, the default, no override is
+// applied.
+func (sm *StartupMonitor) WithPinnedFallbackRevision(revision int) *StartupMonitor {
+	sm.pinnedFallbackRevision = revision
+	return sm
+}
+
+// WithBlacklistedRevisions marks revisions that must never be selected as a fallback target,
+// e.g. because a caller already knows a revision was itself the subject of a previous,
+// unsuccessful fallback. It may be called multiple times; the blacklist accumulates.
+func (sm *StartupMonitor) WithBlacklistedRevisions(revisions ...int) *StartupMonitor {
+	if sm.blacklistedRevisions == nil {
+		sm.blacklistedRevisions = make(map[int]bool, len(revisions))
+	}
+	for _, revision := range revisions {
+		sm.blacklistedRevisions[revision] = true
+	}
+	return sm
+}
+
+// WithUIDProvider overrides how the fallback pod's UID is generated. It defaults to
+// uuid.NewUUID; an embedder may substitute a stable or traceable UID (e.g. derived from the
+// revision) if it chooses.
+func (sm *StartupMonitor) WithUIDProvider(fn UIDProviderFunc) *StartupMonitor {
+	sm.uidProvider = fn
+	return sm
+}
+
+// WithSingleNodeTimeout overrides the timeout applied when a SingleReplica control plane
+// topology is detected.
+func (sm *StartupMonitor) WithSingleNodeTimeout(timeout time.Duration) *StartupMonitor {
+	sm.singleNodeTimeout = timeout
+	return sm
+}
+
+// WithSupersededGracePeriod overrides how long the monitor idles after observing that its
+// revision has been superseded by a newer one before removing its own manifest and exiting.
+func (sm *StartupMonitor) WithSupersededGracePeriod(gracePeriod time.Duration) *StartupMonitor {
+	sm.supersededGracePeriod = gracePeriod
+	return sm
+}
+
+// WithIO overrides the file system implementation used by the monitor, which defaults to
+// realFS. Embedders can supply their own IOInterface to add instrumentation around real file
+// system calls; tests typically use one of the fakes provided by the monitortesting package
+// instead.
+func (sm *StartupMonitor) WithIO(io IOInterface) *StartupMonitor {
+	sm.io = io
+	return sm
+}
+
+// WithClockForTesting overrides the clock used by the monitor to compute the fallback
+// deadline, stabilization window extensions, and holdoff periods. It is only meant to be used
+// in tests, together with a clock.FakeClock, so timeout transitions can be exercised
+// deterministically instead of through real sleeps.
+func (sm *StartupMonitor) WithClockForTesting(c clock.PassiveClock) *StartupMonitor {
+	sm.clock = c
+	return sm
+}
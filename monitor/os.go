@@ -1,19 +1,31 @@
 package monitor
 
 import (
+	"fmt"
 	"io/fs"
 	"io/ioutil"
 	"os"
+	"path"
+	"path/filepath"
+	"syscall"
 )
 
-// ioInterface collects file system level operations that need to be mocked out during tests
-type ioInterface interface {
+// IOInterface collects every file system level operation the monitor and its extension points
+// (custom health checks, fallback guards, embedders of the monitortesting fakes) may need to
+// perform or mock out. sm.io defaults to realFS and can be overridden via WithIO, e.g. with the
+// fakes provided by the monitortesting package.
+type IOInterface interface {
 	Symlink(oldname string, newname string) error
 	Stat(path string) (os.FileInfo, error)
 	Remove(path string) error
 	ReadFile(filename string) ([]byte, error)
 	ReadDir(dirname string) ([]fs.FileInfo, error)
 	WriteFile(filename string, data []byte, perm fs.FileMode) error
+	WriteFileAtomic(filename string, data []byte, perm fs.FileMode) error
+	Rename(oldpath, newpath string) error
+	Chmod(name string, mode fs.FileMode) error
+	EvalSymlinks(path string) (string, error)
+	AvailableBytes(path string) (uint64, error)
 }
 
 // realFS is used to dispatch the real system level operations.
@@ -48,3 +60,57 @@ func (realFS) ReadDir(dirname string) ([]fs.FileInfo, error) {
 func (realFS) WriteFile(filename string, data []byte, perm fs.FileMode) error {
 	return ioutil.WriteFile(filename, data, perm)
 }
+
+// WriteFileAtomic writes data to filename without ever exposing a missing or partially written
+// file to a concurrent reader (notably the kubelet, which watches manifestsPath via inotify): it
+// writes to a hidden ".<name>.tmp" file in the same directory as filename, fsyncs it so the
+// contents survive a crash, closes it, and only then renames it over filename. The temp file is
+// removed if the write or fsync fails before the rename.
+func (realFS) WriteFileAtomic(filename string, data []byte, perm fs.FileMode) error {
+	tmpPath := path.Join(filepath.Dir(filename), fmt.Sprintf(".%s.tmp", filepath.Base(filename)))
+
+	f, err := os.OpenFile(tmpPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		os.Remove(tmpPath)
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		os.Remove(tmpPath)
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	return os.Rename(tmpPath, filename)
+}
+
+// Rename will call os.Rename to rename oldpath to newpath.
+func (realFS) Rename(oldpath, newpath string) error {
+	return os.Rename(oldpath, newpath)
+}
+
+// Chmod will call os.Chmod to change the mode of the named file.
+func (realFS) Chmod(name string, mode fs.FileMode) error {
+	return os.Chmod(name, mode)
+}
+
+// EvalSymlinks will call filepath.EvalSymlinks to resolve any symbolic links in path.
+func (realFS) EvalSymlinks(path string) (string, error) {
+	return filepath.EvalSymlinks(path)
+}
+
+// AvailableBytes will call syscall.Statfs to determine how much free space is available to an
+// unprivileged user on the filesystem that holds path.
+func (realFS) AvailableBytes(path string) (uint64, error) {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(path, &stat); err != nil {
+		return 0, err
+	}
+	return uint64(stat.Bavail) * uint64(stat.Bsize), nil
+}
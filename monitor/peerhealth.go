@@ -0,0 +1,124 @@
+package monitor
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// masterNodeLabel selects the control plane nodes to probe for peer apiserver health.
+const masterNodeLabel = "node-role.kubernetes.io/master"
+
+// peerAPIServerHealthzPort is the secure port the apiserver's /healthz endpoint listens on.
+const peerAPIServerHealthzPort = 6443
+
+// peerHealthCheckTimeout bounds how long a single peer's /healthz probe may take.
+const peerHealthCheckTimeout = 2 * time.Second
+
+// peerHealthHTTPClient probes peer apiservers' /healthz endpoints. Peer serving certificates
+// aren't available to this process, so verification is skipped: this call only establishes
+// reachability, not identity.
+//
+// It is shared across calls and ticks rather than built per probe, and keeps its idle
+// connections and TLS sessions around for peerHealthIdleConnTimeout, so a probe interval as low
+// as 1s reuses the existing TCP connection and resumes the existing TLS session with each peer
+// instead of paying for a full handshake on every tick.
+var peerHealthHTTPClient = &http.Client{
+	Timeout: peerHealthCheckTimeout,
+	Transport: &http.Transport{
+		TLSClientConfig:     &tls.Config{InsecureSkipVerify: true, ClientSessionCache: tls.NewLRUClientSessionCache(0)},
+		MaxIdleConns:        peerHealthMaxIdleConns,
+		MaxIdleConnsPerHost: peerHealthMaxIdleConns,
+		IdleConnTimeout:     peerHealthIdleConnTimeout,
+	},
+}
+
+// peerHealthMaxIdleConns bounds how many idle connections peerHealthHTTPClient keeps open at
+// once. Control plane node counts are small, but this is set generously above the typical
+// handful of masters so no peer is ever forced to reconnect just because another peer's
+// connection is occupying the cache.
+const peerHealthMaxIdleConns = 16
+
+// peerHealthIdleConnTimeout is how long peerHealthHTTPClient keeps an idle connection to a peer
+// open, comfortably above the probe interval so a 1s countdown never lets the connection go
+// idle long enough to be closed between ticks.
+const peerHealthIdleConnTimeout = 90 * time.Second
+
+// anyPeerAPIServerHealthy reports whether any other master node's apiserver, or the bootstrap
+// apiserver, answers its /healthz endpoint. It requires sm.client to check the other masters;
+// when it is unset, or there are no other apiservers to check, it reports true so the fallback
+// decision is unaffected by default.
+func (sm *StartupMonitor) anyPeerAPIServerHealthy(ctx context.Context) (bool, error) {
+	sawPeer := false
+
+	if len(sm.bootstrapAPIServerEndpoint) > 0 {
+		sawPeer = true
+		if isPeerAPIServerHealthy(ctx, sm.bootstrapAPIServerEndpoint) {
+			return true, nil
+		}
+	}
+
+	if !sm.hasClient() {
+		return !sawPeer, nil
+	}
+
+	nodeName, err := nodeName()
+	if err != nil {
+		return true, fmt.Errorf("failed to determine the node name: %v", err)
+	}
+
+	nodes, err := sm.client.CoreV1().Nodes().List(ctx, metav1.ListOptions{LabelSelector: masterNodeLabel})
+	if err != nil {
+		return true, err
+	}
+
+	for _, node := range nodes.Items {
+		if node.Name == nodeName {
+			continue
+		}
+		address := peerAPIServerAddress(node)
+		if address == "" {
+			continue
+		}
+		sawPeer = true
+		if isPeerAPIServerHealthy(ctx, address) {
+			return true, nil
+		}
+	}
+	if !sawPeer {
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// peerAPIServerAddress returns the internal IP to reach node's apiserver on, or "" if none is
+// reported yet.
+func peerAPIServerAddress(node corev1.Node) string {
+	for _, address := range node.Status.Addresses {
+		if address.Type == corev1.NodeInternalIP {
+			return address.Address
+		}
+	}
+	return ""
+}
+
+// isPeerAPIServerHealthy reports whether the apiserver at address answers its /healthz
+// endpoint with a 200. Any error, timeout, or non-200 response is treated as unhealthy.
+func isPeerAPIServerHealthy(ctx context.Context, address string) bool {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("https://%s:%d/healthz", address, peerAPIServerHealthzPort), nil)
+	if err != nil {
+		return false
+	}
+	resp, err := peerHealthHTTPClient.Do(req)
+	if err != nil {
+		return false
+	}
+	defer resp.Body.Close()
+	return resp.StatusCode == http.StatusOK
+}
@@ -0,0 +1,30 @@
+package monitor
+
+import (
+	"fmt"
+	"path"
+)
+
+// minAvailableManifestBytes is the minimum free space required on the manifests filesystem
+// before the fallback destructive sequence (remove + write) is allowed to start. Below it, a
+// remove followed by a failed write is more likely than a clean rollback.
+const minAvailableManifestBytes = 10 * 1024 * 1024 // 10MiB
+
+// preflightManifestsWritable verifies that sm.manifestsPath has enough free space and is
+// actually writable, so a failure can be reported as a precise degraded reason up front
+// instead of surfacing midway through the fallback's remove+write sequence.
+func (sm *StartupMonitor) preflightManifestsWritable() error {
+	available, err := sm.io.AvailableBytes(sm.manifestsPath)
+	if err != nil {
+		return fmt.Errorf("failed to determine free space on %q: %v", sm.manifestsPath, err)
+	}
+	if available < minAvailableManifestBytes {
+		return fmt.Errorf("%q has only %d bytes free, below the %d byte minimum required to safely fall back", sm.manifestsPath, available, minAvailableManifestBytes)
+	}
+
+	probeFile := path.Join(sm.manifestsPath, fmt.Sprintf(".%s-startup-monitor-preflight", sm.targetName))
+	if err := sm.io.WriteFile(probeFile, []byte{}, 0600); err != nil {
+		return fmt.Errorf("%q does not appear to be writable: %v", sm.manifestsPath, err)
+	}
+	return sm.io.Remove(probeFile)
+}
@@ -0,0 +1,55 @@
+package monitor
+
+import (
+	"time"
+)
+
+// Defaults for monitoring kube-controller-manager, matching the ports and on-disk serving
+// certificate paths OpenShift installs it with.
+const (
+	KubeControllerManagerSecurePort      = 10257
+	KubeControllerManagerServingCertFile = "/etc/kubernetes/static-pod-resources/kube-controller-manager-certs/secrets/serving-cert/tls.crt"
+	KubeControllerManagerServingKeyFile  = "/etc/kubernetes/static-pod-resources/kube-controller-manager-certs/secrets/serving-cert/tls.key"
+	defaultKubeControllerManagerTimeout  = 3 * time.Minute
+)
+
+// KubeControllerManagerProfile bundles the health check, serving certificate, and fallback
+// timeout defaults for monitoring kube-controller-manager, so its operator can adopt the
+// startup-monitor with configuration only:
+//
+//	monitor.NewStartupMonitorCommand(monitor.KubeControllerManagerProfile()...)
+//
+// Any of these can still be overridden by passing further Options after it, or by the usual
+// command line flags.
+func KubeControllerManagerProfile() []Option {
+	return []Option{
+		WithHealthCheck(httpsHealthChecker(KubeControllerManagerSecurePort, "/healthz")),
+		WithServingCertificateDefault(KubeControllerManagerServingCertFile, KubeControllerManagerServingKeyFile),
+		WithFallbackTimeoutDefault(defaultKubeControllerManagerTimeout),
+	}
+}
+
+// Defaults for monitoring kube-scheduler, matching the ports and on-disk serving certificate
+// paths OpenShift installs it with.
+const (
+	KubeSchedulerSecurePort      = 10259
+	KubeSchedulerServingCertFile = "/etc/kubernetes/static-pod-resources/kube-scheduler-certs/secrets/serving-cert/tls.crt"
+	KubeSchedulerServingKeyFile  = "/etc/kubernetes/static-pod-resources/kube-scheduler-certs/secrets/serving-cert/tls.key"
+	defaultKubeSchedulerTimeout  = 3 * time.Minute
+)
+
+// KubeSchedulerProfile bundles the health check, serving certificate, and fallback timeout
+// defaults for monitoring kube-scheduler, so its operator can adopt the startup-monitor with
+// configuration only:
+//
+//	monitor.NewStartupMonitorCommand(monitor.KubeSchedulerProfile()...)
+//
+// Any of these can still be overridden by passing further Options after it, or by the usual
+// command line flags.
+func KubeSchedulerProfile() []Option {
+	return []Option{
+		WithHealthCheck(httpsHealthChecker(KubeSchedulerSecurePort, "/healthz")),
+		WithServingCertificateDefault(KubeSchedulerServingCertFile, KubeSchedulerServingKeyFile),
+		WithFallbackTimeoutDefault(defaultKubeSchedulerTimeout),
+	}
+}
@@ -0,0 +1,42 @@
+package monitor
+
+import (
+	"fmt"
+	"os"
+	"path"
+
+	"k8s.io/klog/v2"
+)
+
+// protectedRevisionMarkerPathFor returns the path of the marker file that protects revision's
+// resource directory from deletion by an external pruner, sitting next to it under
+// staticPodResourcesPath rather than inside it, so a pruner can decide whether to delete a
+// revision directory without having to open it first.
+func (sm *StartupMonitor) protectedRevisionMarkerPathFor(revision int) string {
+	return path.Join(sm.staticPodResourcesPath, fmt.Sprintf("%s-pod-%d.protected", sm.targetName, revision))
+}
+
+// protectRevision marks revision as the current fallback target, so a pruner consulting
+// IsRevisionProtected never deletes it out from under a future fallback. It is idempotent.
+func (sm *StartupMonitor) protectRevision(revision int) error {
+	return retryOnTransientError(func() error {
+		return sm.io.WriteFile(sm.protectedRevisionMarkerPathFor(revision), nil, 0644)
+	})
+}
+
+// unprotectRevision removes the protection marker for revision, once it has been superseded as
+// the last known good revision. It is best-effort: a pruner that runs before this completes will
+// simply skip the revision for one more cycle.
+func (sm *StartupMonitor) unprotectRevision(revision int) {
+	if err := sm.io.Remove(sm.protectedRevisionMarkerPathFor(revision)); err != nil && !os.IsNotExist(err) {
+		klog.Warningf("Unable to remove the protection marker for %s revision %d: %v", sm.targetName, revision, err)
+	}
+}
+
+// IsRevisionProtected reports whether revision is currently marked, via protectRevision, as the
+// last known good fallback target for the operand described by info. A pruner should consult it
+// before deleting a revision's resource directory, so it never removes the revision a fallback
+// would otherwise land on.
+func IsRevisionProtected(info TargetInfo, revision int) (bool, error) {
+	return info.toStartupMonitor().fileExists(info.toStartupMonitor().protectedRevisionMarkerPathFor(revision))
+}
@@ -0,0 +1,40 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// isMirrorPodReady reports whether the target's mirror pod exists on the API server and is
+// reporting Ready. A positive result also transitively proves the apiserver on this node is
+// able to serve kubelet traffic, since the mirror pod is created and kept up to date by this
+// node's own kubelet.
+//
+// This is an additional, optional health signal: it requires sm.client, and when no client
+// is configured it reports healthy so that offline monitors behave exactly as before.
+func (sm *StartupMonitor) isMirrorPodReady(ctx context.Context) (bool, error) {
+	if !sm.hasClient() {
+		return true, nil
+	}
+
+	nodeName, err := nodeName()
+	if err != nil {
+		return false, fmt.Errorf("failed to determine the node name: %v", err)
+	}
+
+	mirrorPodName := fmt.Sprintf("%s-%s", sm.targetName, nodeName)
+	pod, err := sm.client.CoreV1().Pods(sm.targetNamespace).Get(ctx, mirrorPodName, metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,88 @@
+package monitor
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/p0lyn0mial/startup-monitor/monitor/monitortesting"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// TestRecordReplayReproducesFallback demonstrates the intended workflow for
+// monitortesting.RecordingIO and ReplayIO: record a real fallback run against a real
+// filesystem, then replay the resulting trace against a target with no filesystem behind it at
+// all, and assert the replay reproduces the exact same outcome. This is how a field-reported
+// fallback failure's on-disk trace could be captured once and replayed forever after as a
+// regression test.
+func TestRecordReplayReproducesFallback(t *testing.T) {
+	recorded := newIntegrationTarget(t, 10)
+	writeIntegrationRevision(t, recorded, 9)
+	writeIntegrationRevision(t, recorded, 10)
+	recorded.WithUIDProvider(func() types.UID { return "fixed-uid" })
+
+	var trace bytes.Buffer
+	recorded.io = monitortesting.NewRecordingIO(recorded.io, &trace)
+
+	if err := recorded.fallbackToPreviousRevision(""); err != nil {
+		t.Fatalf("unexpected error recording the fallback: %v", err)
+	}
+	recordedPod, err := recorded.readTargetPod(recorded.rootManifestPath())
+	if err != nil {
+		t.Fatalf("failed to read the recorded root manifest: %v", err)
+	}
+	recordedRevision, err := revisionOfPod(recordedPod)
+	if err != nil {
+		t.Fatalf("failed to extract the recorded revision: %v", err)
+	}
+
+	replay, err := monitortesting.NewReplayIO(&trace)
+	if err != nil {
+		t.Fatalf("failed to parse the recorded trace: %v", err)
+	}
+
+	// replayed shares none of recorded's on-disk state: every call it makes is answered from
+	// the trace, not a real filesystem.
+	replayed := newIntegrationTarget(t, 10)
+	replayed.io = replay
+	replayed.WithUIDProvider(func() types.UID { return "fixed-uid" })
+
+	if err := replayed.fallbackToPreviousRevision(""); err != nil {
+		t.Fatalf("unexpected error replaying the fallback: %v", err)
+	}
+	replayedPod, err := replayed.readTargetPod(replayed.rootManifestPath())
+	if err != nil {
+		t.Fatalf("failed to read the replayed root manifest: %v", err)
+	}
+	replayedRevision, err := revisionOfPod(replayedPod)
+	if err != nil {
+		t.Fatalf("failed to extract the replayed revision: %v", err)
+	}
+
+	if replayedRevision != recordedRevision {
+		t.Errorf("replayed revision %d, expected the recorded revision %d", replayedRevision, recordedRevision)
+	}
+	if replayedPod.UID != recordedPod.UID {
+		t.Errorf("replayed UID %q, expected the recorded UID %q", replayedPod.UID, recordedPod.UID)
+	}
+}
+
+// TestReplayIODetectsDivergingCallSequence asserts ReplayIO fails loudly, rather than silently
+// returning a mismatched result, when the code under test diverges from the recorded trace by
+// calling a different operation than the one recorded next.
+func TestReplayIODetectsDivergingCallSequence(t *testing.T) {
+	var trace bytes.Buffer
+	recording := monitortesting.NewRecordingIO(realFS{}, &trace)
+	dir := t.TempDir()
+	if _, err := recording.Stat(dir); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	replay, err := monitortesting.NewReplayIO(&trace)
+	if err != nil {
+		t.Fatalf("failed to parse the recorded trace: %v", err)
+	}
+
+	if err := replay.Remove(dir); err == nil {
+		t.Fatal("expected an error replaying a call that diverges from the recorded trace")
+	}
+}
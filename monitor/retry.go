@@ -0,0 +1,76 @@
+package monitor
+
+import (
+	"errors"
+	"syscall"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// apiServerRetryBackoff bounds how long a transient apiserver call (currently just patchNodeState)
+// is retried before being treated as a real failure. Kept short since these calls are best-effort
+// signals, not something worth stalling a sync iteration over.
+var apiServerRetryBackoff = wait.Backoff{
+	Duration: 100 * time.Millisecond,
+	Factor:   2,
+	Steps:    3,
+}
+
+// retryOnAPIServerError calls op, retrying with apiServerRetryBackoff while it fails with a
+// conflict, server-timeout, or too-many-requests error, the apiserver-side analogues of
+// isTransientWriteError. It returns op's last error, transient or not, once retries are
+// exhausted or op fails with a non-transient error.
+func retryOnAPIServerError(op func() error) error {
+	var lastErr error
+	if err := wait.ExponentialBackoff(apiServerRetryBackoff, func() (bool, error) {
+		lastErr = op()
+		if lastErr == nil {
+			return true, nil
+		}
+		if !apierrors.IsConflict(lastErr) && !apierrors.IsServerTimeout(lastErr) && !apierrors.IsTooManyRequests(lastErr) {
+			return false, lastErr
+		}
+		return false, nil
+	}); err != nil && !errors.Is(err, wait.ErrWaitTimeout) {
+		return err
+	}
+	return lastErr
+}
+
+// writeRetryBackoff bounds how long a transient manifest write or removal is retried before
+// being treated as a real failure. A single flaky write currently fails the whole fallback
+// iteration and restarts the analysis on the next sync, so it's worth a few quick retries
+// first.
+var writeRetryBackoff = wait.Backoff{
+	Duration: 100 * time.Millisecond,
+	Factor:   2,
+	Steps:    4,
+}
+
+// isTransientWriteError reports whether err looks like a transient I/O error worth retrying,
+// as opposed to e.g. a permission or not-exist error that a retry can't fix.
+func isTransientWriteError(err error) bool {
+	return errors.Is(err, syscall.EIO) || errors.Is(err, syscall.EBUSY) || errors.Is(err, syscall.ETXTBSY)
+}
+
+// retryOnTransientError calls op, retrying with writeRetryBackoff while it fails with a
+// transient error. It returns op's last error, transient or not, once retries are exhausted or
+// op fails with a non-transient error.
+func retryOnTransientError(op func() error) error {
+	var lastErr error
+	if err := wait.ExponentialBackoff(writeRetryBackoff, func() (bool, error) {
+		lastErr = op()
+		if lastErr == nil {
+			return true, nil
+		}
+		if !isTransientWriteError(lastErr) {
+			return false, lastErr
+		}
+		return false, nil
+	}); err != nil && !errors.Is(err, wait.ErrWaitTimeout) {
+		return err
+	}
+	return lastErr
+}
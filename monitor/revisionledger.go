@@ -0,0 +1,52 @@
+package monitor
+
+import (
+	"strconv"
+	"strings"
+
+	"k8s.io/klog/v2"
+)
+
+// readRevisionLedger reads a newline-separated list of revision numbers from filepath, in the
+// order they were written. A missing file is reported as an empty list, so callers don't need
+// to special-case a ledger that hasn't been written to yet.
+func (sm *StartupMonitor) readRevisionLedger(filepath string) ([]int, error) {
+	exists, err := sm.fileExists(filepath)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, nil
+	}
+
+	data, err := sm.io.ReadFile(filepath)
+	if err != nil {
+		return nil, err
+	}
+
+	var revisions []int
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		revision, err := strconv.Atoi(line)
+		if err != nil {
+			klog.Warningf("Unable to parse revision ledger entry %q at %q for %s, skipping it: %v", line, filepath, sm.targetName, err)
+			continue
+		}
+		revisions = append(revisions, revision)
+	}
+	return revisions, nil
+}
+
+// writeRevisionLedger writes revisions to filepath, one per line, in order.
+func (sm *StartupMonitor) writeRevisionLedger(filepath string, revisions []int) error {
+	lines := make([]string, len(revisions))
+	for i, revision := range revisions {
+		lines[i] = strconv.Itoa(revision)
+	}
+	return retryOnTransientError(func() error {
+		return sm.io.WriteFile(filepath, []byte(strings.Join(lines, "\n")), 0644)
+	})
+}
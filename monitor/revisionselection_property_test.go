@@ -0,0 +1,144 @@
+package monitor
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+
+	"github.com/p0lyn0mial/startup-monitor/monitor/monitortesting"
+)
+
+// revisionSelectionPropertyTrials bounds how many random directory listings each property test
+// below explores; generous enough to shake out edge cases from mixed valid and malformed input
+// without making the suite slow.
+const revisionSelectionPropertyTrials = 200
+
+// randomRevisionListing returns a shuffled os.FileInfo listing for revisions, plus a handful of
+// entries that must never be mistaken for a revision directory: a file sharing a revision's
+// name, an unrelated directory, an unrelated file, and a directory whose suffix doesn't parse as
+// a number. Exercising scanPreviousRevision against this clutter, rather than a clean listing,
+// is the point of a property test over the table-driven cases above.
+func randomRevisionListing(r *rand.Rand, targetName string, revisions []int) []os.FileInfo {
+	entries := []os.FileInfo{
+		monitortesting.FakeFile(fmt.Sprintf("%s-pod-%d", targetName, revisions[0])),
+		monitortesting.FakeDir("unrelated-directory"),
+		monitortesting.FakeFile("unrelated-file"),
+	}
+	for _, revision := range revisions {
+		entries = append(entries, monitortesting.FakeDir(fmt.Sprintf("%s-pod-%d", targetName, revision)))
+	}
+	r.Shuffle(len(entries), func(i, j int) { entries[i], entries[j] = entries[j], entries[i] })
+	return entries
+}
+
+// TestScanPreviousRevisionPropertyRespectsCurrentAndBlacklist generates arbitrary mixes of
+// revision directories, files, and unrelated entries and asserts that whenever
+// scanPreviousRevision finds a previous revision, it is strictly older than the current
+// revision and never one of the blacklisted revisions -- the two invariants
+// WithBlacklistedRevisions and the current-revision guard exist to uphold.
+func TestScanPreviousRevisionPropertyRespectsCurrentAndBlacklist(t *testing.T) {
+	for trial := 0; trial < revisionSelectionPropertyTrials; trial++ {
+		r := rand.New(rand.NewSource(int64(trial)))
+
+		revisionCount := 2 + r.Intn(6)
+		seen := map[int]bool{}
+		var revisions []int
+		for len(revisions) < revisionCount {
+			revision := r.Intn(50)
+			if seen[revision] {
+				continue
+			}
+			seen[revision] = true
+			revisions = append(revisions, revision)
+		}
+
+		currentRevision := revisions[0]
+		for _, revision := range revisions {
+			if revision > currentRevision {
+				currentRevision = revision
+			}
+		}
+
+		blacklisted := map[int]bool{}
+		for _, revision := range revisions {
+			if revision != currentRevision && r.Intn(2) == 0 {
+				blacklisted[revision] = true
+			}
+		}
+
+		target := New(nil)
+		target.targetName = "kube-apiserver"
+		target.staticPodResourcesPath = "/etc/kubernetes/static-pod-resources"
+		target.revision = currentRevision
+		for revision := range blacklisted {
+			target.WithBlacklistedRevisions(revision)
+		}
+		target.io = &monitortesting.FakeIO{
+			ReadDirFn: func(string) ([]os.FileInfo, error) {
+				return randomRevisionListing(r, target.targetName, revisions), nil
+			},
+		}
+
+		prevRev, found, err := target.scanPreviousRevision()
+		if err != nil {
+			t.Fatalf("trial %d: unexpected error: %v", trial, err)
+		}
+		if !found {
+			continue
+		}
+		if prevRev >= currentRevision {
+			t.Fatalf("trial %d: selected revision %d, expected strictly less than the current revision %d", trial, prevRev, currentRevision)
+		}
+		if blacklisted[prevRev] {
+			t.Fatalf("trial %d: selected blacklisted revision %d", trial, prevRev)
+		}
+	}
+}
+
+// TestFallbackToPreviousRevisionPropertyNeverAdoptsAMissingManifest generates random subsets of
+// on-disk revisions, deletes the target manifest for whichever previous revision would otherwise
+// be selected, and asserts fallbackToPreviousRevision never adopts it: it must either fall back
+// to a healthy root manifest or leave the root manifest untouched, but never one referencing the
+// revision whose manifest is missing.
+func TestFallbackToPreviousRevisionPropertyNeverAdoptsAMissingManifest(t *testing.T) {
+	for trial := 0; trial < revisionSelectionPropertyTrials; trial++ {
+		r := rand.New(rand.NewSource(int64(1000 + trial)))
+
+		currentRevision := 10 + r.Intn(5)
+		previousRevision := currentRevision - 1 - r.Intn(3)
+
+		target := newIntegrationTarget(t, currentRevision)
+		writeIntegrationRevision(t, target, currentRevision)
+		writeIntegrationRevision(t, target, previousRevision)
+
+		// Remove the previous revision's manifest so it can be discovered as a directory but
+		// never adopted as a fallback target.
+		if err := os.Remove(target.targetManifestPathFor(previousRevision)); err != nil {
+			t.Fatalf("trial %d: failed to remove the previous revision's manifest: %v", trial, err)
+		}
+
+		if err := target.fallbackToPreviousRevision(""); err != nil {
+			t.Fatalf("trial %d: unexpected error: %v", trial, err)
+		}
+
+		rootManifestExists, err := target.fileExists(target.rootManifestPath())
+		if err != nil {
+			t.Fatalf("trial %d: unexpected error checking the root manifest: %v", trial, err)
+		}
+		if !rootManifestExists {
+			continue
+		}
+		rolledBackPod, err := target.readTargetPod(target.rootManifestPath())
+		if err != nil {
+			t.Fatalf("trial %d: root manifest is not a valid pod: %v", trial, err)
+		}
+		revision, err := revisionOfPod(rolledBackPod)
+		if err != nil {
+			t.Fatalf("trial %d: root manifest doesn't carry a valid revision: %v", trial, err)
+		}
+		if revision == previousRevision {
+			t.Fatalf("trial %d: fell back to revision %d whose manifest was missing", trial, previousRevision)
+		}
+	}
+}
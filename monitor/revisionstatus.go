@@ -0,0 +1,40 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// revisionStatusConfigMapName returns the name of the revision-status ConfigMap the
+// installer controller maintains for revision, in the operand namespace.
+func revisionStatusConfigMapName(revision int) string {
+	return fmt.Sprintf("revision-status-%d", revision)
+}
+
+// revisionAbandonedClusterWide reports whether the installer controller has already marked
+// the guarded revision Failed or Abandoned cluster-wide, by reading its revision-status
+// ConfigMap. It requires sm.client; when it is unset, or the ConfigMap doesn't exist yet, it
+// reports false so the local decision is unaffected.
+func (sm *StartupMonitor) revisionAbandonedClusterWide(ctx context.Context) (bool, error) {
+	if !sm.hasClient() {
+		return false, nil
+	}
+
+	configMap, err := sm.client.CoreV1().ConfigMaps(sm.targetNamespace).Get(ctx, revisionStatusConfigMapName(sm.revision), metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	switch configMap.Data["status"] {
+	case "Failed", "Abandoned":
+		return true, nil
+	default:
+		return false, nil
+	}
+}
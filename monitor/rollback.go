@@ -0,0 +1,70 @@
+package monitor
+
+import "k8s.io/apimachinery/pkg/util/clock"
+
+// TargetInfo carries the explicit inputs required to perform a fallback for a single
+// operand, independent of a running StartupMonitor. It lets the installer or other node
+// tooling reuse exactly the same rollback semantics without spinning up the sync loop.
+type TargetInfo struct {
+	// TargetName hold the name of the operand
+	TargetName string
+
+	// ManifestsPath points to the directory that holds the root manifests
+	ManifestsPath string
+
+	// StaticPodResourcesPath points to the directory that holds revisioned manifests
+	StaticPodResourcesPath string
+
+	// Revision is the revision to fall back from (Fallback) or record as last known good
+	// (CreateLastKnownGoodRevision).
+	Revision int
+
+	// IO collects the file system level operations to use. When nil, the real file system is used.
+	IO IOInterface
+
+	// FallbackMetadataFuncs are invoked at fallback time to compute extra annotations/labels
+	// applied to the fallback pod. Only used by Fallback.
+	FallbackMetadataFuncs []FallbackMetadataFunc
+
+	// AnnotationPrefix overrides the annotation domain prefix applied to the fallback pod's
+	// manifest. Left empty, the default, defaultAnnotationPrefix is used. Only used by
+	// Fallback.
+	AnnotationPrefix string
+
+	// FailureReason optionally records why the fallback was performed, applied as the
+	// fallback-reason annotation. Left empty, the default, no reason annotation is applied.
+	// Only used by Fallback.
+	FailureReason string
+}
+
+func (info TargetInfo) toStartupMonitor() *StartupMonitor {
+	io := info.IO
+	if io == nil {
+		io = realFS{}
+	}
+	return &StartupMonitor{
+		targetName:             info.TargetName,
+		manifestsPath:          info.ManifestsPath,
+		staticPodResourcesPath: info.StaticPodResourcesPath,
+		revision:               info.Revision,
+		io:                     io,
+		clock:                  clock.RealClock{},
+		layout:                 openshiftLayout{},
+		fallbackMetadataFuncs:  info.FallbackMetadataFuncs,
+		annotationPrefix:       info.AnnotationPrefix,
+	}
+}
+
+// Fallback performs the same rollback-to-previous-revision procedure the sync loop runs on
+// timeout: it locates (or creates) the last known good revision, annotates it and writes it
+// as the new root manifest, causing the kubelet to restart the operand on the previous revision.
+func Fallback(info TargetInfo) error {
+	return info.toStartupMonitor().fallbackToPreviousRevision(info.FailureReason)
+}
+
+// CreateLastKnownGoodRevision records info.Revision as the last known good revision for the
+// target and removes the startup monitor's static pod manifest, the same way the sync loop
+// does when it observes a healthy target.
+func CreateLastKnownGoodRevision(info TargetInfo) error {
+	return info.toStartupMonitor().createLastKnowGoodRevisionAndDestroy()
+}
@@ -0,0 +1,39 @@
+package monitor
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// isClusterProgressing reports whether the operator.openshift.io resource identified by
+// sm.operatorResource currently reports Progressing=True, meaning the control plane as a
+// whole is mid-rollout. It requires sm.dynamicClient and sm.operatorResource; when either is
+// unset it reports false so the monitor's fallback behavior is unaffected.
+func (sm *StartupMonitor) isClusterProgressing(ctx context.Context) (bool, error) {
+	if !sm.hasOperatorResource() {
+		return false, nil
+	}
+
+	operator, err := sm.dynamicClient.Resource(sm.operatorResource).Get(ctx, clusterOperatorResourceName, metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	conditions, _, err := unstructured.NestedSlice(operator.Object, "status", "conditions")
+	if err != nil {
+		return false, err
+	}
+	for _, existing := range conditions {
+		condition, ok := existing.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if condition["type"] == "Progressing" {
+			status, _ := condition["status"].(string)
+			return status == "True", nil
+		}
+	}
+	return false, nil
+}
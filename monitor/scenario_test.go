@@ -0,0 +1,77 @@
+package monitor
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"sigs.k8s.io/yaml"
+)
+
+// BehaviorExpectation describes the final Status a BehaviorScenario must reach once replayed.
+type BehaviorExpectation struct {
+	// Phase is the expected final Status.Phase.
+	Phase Phase `json:"phase"`
+
+	// Revision is the expected final Status.Revision.
+	Revision int `json:"revision"`
+}
+
+// BehaviorScenario extends SimulationScenario with the outcome it must produce, so a single
+// YAML file under testdata/scenarios is both the input to and the assertion for a table-driven
+// behavior test, letting operand teams contribute new edge cases as data instead of editing the
+// per-function mock-counter tests in this package.
+type BehaviorScenario struct {
+	SimulationScenario
+
+	// Expect is the final Status the scenario must reach once replayed.
+	Expect BehaviorExpectation `json:"expect"`
+}
+
+// loadBehaviorScenario reads and parses a BehaviorScenario from path, applying
+// SimulationScenario's documented defaults to any zero-valued field.
+func loadBehaviorScenario(t *testing.T, path string) *BehaviorScenario {
+	t.Helper()
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read scenario %q: %v", path, err)
+	}
+	scenario := &BehaviorScenario{}
+	if err := yaml.UnmarshalStrict(raw, scenario); err != nil {
+		t.Fatalf("failed to parse scenario %q: %v", path, err)
+	}
+	scenario.applyDefaults()
+	return scenario
+}
+
+// TestBehaviorScenarios replays every YAML scenario under testdata/scenarios against a fresh
+// sandbox directory and asserts the monitor reaches its Expect once the run completes.
+func TestBehaviorScenarios(t *testing.T) {
+	paths, err := filepath.Glob("testdata/scenarios/*.yaml")
+	if err != nil {
+		t.Fatalf("failed to list scenario files: %v", err)
+	}
+	if len(paths) == 0 {
+		t.Fatal("expected at least one scenario file under testdata/scenarios")
+	}
+
+	for _, scenarioPath := range paths {
+		scenarioPath := scenarioPath
+		t.Run(filepath.Base(scenarioPath), func(t *testing.T) {
+			scenario := loadBehaviorScenario(t, scenarioPath)
+
+			status, err := RunSimulation(&scenario.SimulationScenario, t.TempDir())
+			if err != nil {
+				t.Fatalf("unexpected error running the scenario: %v", err)
+			}
+
+			if status.Phase != scenario.Expect.Phase {
+				t.Errorf("unexpected phase %v, expected %v", status.Phase, scenario.Expect.Phase)
+			}
+			if status.Revision != scenario.Expect.Revision {
+				t.Errorf("unexpected revision %d, expected %d", status.Revision, scenario.Expect.Revision)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package monitor
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+
+	"github.com/openshift/library-go/pkg/operator/resource/resourceread"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// selfManifestFileName returns the name of the monitor's own static pod manifest, written by
+// the installer alongside the operand's manifests.
+func selfManifestFileName(targetName string) string {
+	return fmt.Sprintf("%s-startup-monitor.yaml", targetName)
+}
+
+// SelfManifest is the configuration discovered by introspecting the monitor's own static pod
+// manifest: its image, the revision it was installed for, and its mount paths. It lets an
+// operator derive its startup-monitor configuration from one authoritative artifact instead
+// of duplicating the same values across the container command, env vars and volume mounts.
+type SelfManifest struct {
+	// Image is the container image the monitor itself is running as.
+	Image string
+
+	// Revision is the revision label on the monitor's own manifest.
+	Revision int
+
+	// MountPaths are the host paths mounted into the monitor's container.
+	MountPaths []string
+}
+
+// SelfManifestInfo carries the explicit inputs required to locate and read the monitor's own
+// static pod manifest, independent of a running StartupMonitor.
+type SelfManifestInfo struct {
+	// TargetName holds the name of the operand.
+	TargetName string
+
+	// ManifestsPath points to the directory that holds the root manifests, including the
+	// monitor's own <target>-startup-monitor.yaml.
+	ManifestsPath string
+
+	// IO collects the file system level operations to use. When nil, the real file system is used.
+	IO IOInterface
+}
+
+// LoadSelfManifest reads the monitor's own static pod manifest, <target>-startup-monitor.yaml,
+// out of info.ManifestsPath and derives a SelfManifest from it.
+func LoadSelfManifest(info SelfManifestInfo) (*SelfManifest, error) {
+	io := info.IO
+	if io == nil {
+		io = realFS{}
+	}
+
+	filePath := path.Join(info.ManifestsPath, selfManifestFileName(info.TargetName))
+	rawManifest, err := io.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	pod, err := resourceread.ReadPodV1(rawManifest)
+	if err != nil {
+		return nil, err
+	}
+
+	revisionString, found := pod.Labels["revision"]
+	if !found || len(revisionString) == 0 {
+		return nil, fmt.Errorf("pod %s doesn't have a revision label", pod.Name)
+	}
+	revision, err := strconv.Atoi(revisionString)
+	if err != nil || revision < 0 {
+		return nil, fmt.Errorf("invalid revision label on pod %s: %q", pod.Name, revisionString)
+	}
+
+	if len(pod.Spec.Containers) == 0 {
+		return nil, fmt.Errorf("pod %s doesn't have any containers", pod.Name)
+	}
+
+	return &SelfManifest{
+		Image:      pod.Spec.Containers[0].Image,
+		Revision:   revision,
+		MountPaths: hostPathMountsOf(pod.Spec.Volumes),
+	}, nil
+}
+
+// hostPathMountsOf returns the host paths mounted by volumes.
+func hostPathMountsOf(volumes []corev1.Volume) []string {
+	var mounts []string
+	for _, volume := range volumes {
+		if volume.HostPath != nil {
+			mounts = append(mounts, volume.HostPath.Path)
+		}
+	}
+	return mounts
+}
@@ -2,20 +2,139 @@ package monitor
 
 import (
 	"context"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
 
-	"k8s.io/apiserver/pkg/server"
 	"k8s.io/klog/v2"
 )
 
-// SetupSignalContext registers for SIGTERM and SIGINT and returns a context
-// that will be cancelled once a signal is received.
-func SetupSignalContext(baseCtx context.Context) context.Context {
+// shutdownSignals are the signals SetupSignalContext reacts to.
+var shutdownSignals = []os.Signal{syscall.SIGTERM, os.Interrupt}
+
+// criticalSectionDrainTimeout bounds how long a second shutdown signal waits for an
+// in-progress critical section to finish before forcing the process to exit anyway, so an
+// operator or kubelet impatiently repeating a SIGTERM can't wait forever, but is given a fair
+// chance to let a short, in-flight manifest swap complete first.
+const criticalSectionDrainTimeout = 30 * time.Second
+
+// criticalSection tracks in-progress spans that must not be interrupted by a forced exit, such
+// as the brief window in fallbackToPreviousRevision between removing the old manifest and
+// writing the new one, where an interruption would leave the node with no operand manifest at
+// all.
+var criticalSection sync.WaitGroup
+
+// enterCriticalSection marks the start of a span that a second shutdown signal waits out (up to
+// criticalSectionDrainTimeout) before forcing the process to exit. The caller must call the
+// returned func exactly once, typically via defer, to mark the end of the span.
+func enterCriticalSection() func() {
+	criticalSection.Add(1)
+	return criticalSection.Done
+}
+
+// SignalContextOption customizes SetupSignalContext. See WithCriticalSectionDrainTimeout.
+type SignalContextOption func(*signalContextConfig)
+
+type signalContextConfig struct {
+	drainTimeout time.Duration
+}
+
+// WithCriticalSectionDrainTimeout overrides how long a second shutdown signal waits for an
+// in-progress critical section to finish before forcing the process to exit anyway, in place of
+// the default, criticalSectionDrainTimeout. A caller whose critical sections (e.g. a slower
+// filesystem under the manifest swap) routinely run longer than the default can use this to
+// avoid a forced exit that would otherwise cut one off in progress.
+func WithCriticalSectionDrainTimeout(timeout time.Duration) SignalContextOption {
+	return func(c *signalContextConfig) {
+		c.drainTimeout = timeout
+	}
+}
+
+// SetupSignalContext registers for SIGTERM and SIGINT against baseCtx and returns a context
+// that will be cancelled once a signal is received, along with a cleanup function. A second
+// signal forces the process to exit, but first waits up to the drain timeout (criticalSectionDrainTimeout
+// by default, overridable via WithCriticalSectionDrainTimeout) for any span started with
+// enterCriticalSection to finish, so a second, impatient shutdown request can't leave the node
+// with no operand manifest at all.
+//
+// Callers must call the returned cleanup function once they're done with the context, typically
+// via defer, so the signal goroutine stops deterministically instead of leaking for the
+// lifetime of the process; this matters for embedders and tests that call SetupSignalContext
+// more than once in the same process.
+func SetupSignalContext(baseCtx context.Context, opts ...SignalContextOption) (context.Context, func()) {
+	shutdownHandler := make(chan os.Signal, 2)
+	signal.Notify(shutdownHandler, shutdownSignals...)
+
+	cfg := signalContextConfig{drainTimeout: criticalSectionDrainTimeout}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	shutdownCtx, cleanup := setupSignalContext(baseCtx, shutdownHandler, os.Exit, cfg.drainTimeout)
+	return shutdownCtx, func() {
+		signal.Stop(shutdownHandler)
+		cleanup()
+	}
+}
+
+// setupSignalContext is SetupSignalContext's testable core: it reacts to incoming exactly as
+// SetupSignalContext reacts to OS signals, but takes incoming and exit as parameters instead of
+// wiring them to os/signal and os.Exit, so a test can inject a synthetic channel and a captured
+// exit function to assert shutdown behavior -- including the forced exit after a second signal,
+// and a second signal arriving mid-fallback -- without registering real OS signal handlers or
+// actually terminating the test process.
+func setupSignalContext(baseCtx context.Context, incoming <-chan os.Signal, exit func(code int), drainTimeout time.Duration) (context.Context, func()) {
 	shutdownCtx, cancel := context.WithCancel(baseCtx)
-	shutdownHandler := server.SetupSignalHandler()
+
+	done := make(chan struct{})
 	go func() {
-		defer cancel()
-		<-shutdownHandler
-		klog.Infof("Received SIGTERM or SIGINT signal, shutting down the process.")
+		// Prefer done over incoming when both are already ready, e.g. cleanup ran before this
+		// goroutine got scheduled: without this priority check, select would pick between them
+		// at random and could react to a signal delivered after cleanup.
+		select {
+		case <-done:
+			return
+		default:
+		}
+
+		select {
+		case <-incoming:
+			klog.Infof("Received SIGTERM or SIGINT signal, shutting down the process.")
+			cancel()
+		case <-done:
+			return
+		}
+
+		select {
+		case <-incoming:
+			klog.Warningf("Received a second SIGTERM or SIGINT signal, waiting up to %s for an in-progress critical section to finish before exiting", drainTimeout)
+			waitForCriticalSection(drainTimeout)
+			exit(1)
+		case <-done:
+			return
+		}
 	}()
-	return shutdownCtx
+
+	cleanup := func() {
+		close(done)
+	}
+	return shutdownCtx, cleanup
+}
+
+// waitForCriticalSection blocks until every span started with enterCriticalSection has ended,
+// or until timeout elapses, whichever comes first.
+func waitForCriticalSection(timeout time.Duration) {
+	drained := make(chan struct{})
+	go func() {
+		criticalSection.Wait()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+	case <-time.After(timeout):
+		klog.Warningf("Timed out waiting for the in-progress critical section to finish, exiting anyway")
+	}
 }
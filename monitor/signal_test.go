@@ -0,0 +1,128 @@
+package monitor
+
+import (
+	"context"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// waitForContextDone fails t if ctx isn't cancelled within timeout.
+func waitForContextDone(t *testing.T, ctx context.Context, timeout time.Duration) {
+	t.Helper()
+	select {
+	case <-ctx.Done():
+	case <-time.After(timeout):
+		t.Fatalf("expected the context to be cancelled within %s", timeout)
+	}
+}
+
+func TestSetupSignalContextCancelsOnFirstSignal(t *testing.T) {
+	incoming := make(chan os.Signal, 2)
+	var exitCode int32 = -1
+	ctx, cleanup := setupSignalContext(context.Background(), incoming, func(code int) { atomic.StoreInt32(&exitCode, int32(code)) }, criticalSectionDrainTimeout)
+	defer cleanup()
+
+	incoming <- os.Interrupt
+	waitForContextDone(t, ctx, time.Second)
+
+	if code := atomic.LoadInt32(&exitCode); code != -1 {
+		t.Errorf("expected a first signal not to force an exit, got exit code %d", code)
+	}
+}
+
+func TestSetupSignalContextForcesExitOnSecondSignal(t *testing.T) {
+	incoming := make(chan os.Signal, 2)
+	exited := make(chan int, 1)
+	ctx, cleanup := setupSignalContext(context.Background(), incoming, func(code int) { exited <- code }, criticalSectionDrainTimeout)
+	defer cleanup()
+
+	incoming <- os.Interrupt
+	waitForContextDone(t, ctx, time.Second)
+
+	incoming <- os.Interrupt
+	select {
+	case code := <-exited:
+		if code != 1 {
+			t.Errorf("unexpected exit code %d, expected 1", code)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected a second signal to force an exit")
+	}
+}
+
+// TestSetupSignalContextWaitsForCriticalSectionOnSecondSignal exercises the exact scenario
+// motivating this test's existence: a second shutdown signal arriving while a critical section
+// (e.g. the brief window mid-fallback between removing the old manifest and writing the new
+// one) is in progress must wait for it to finish before forcing an exit.
+func TestSetupSignalContextWaitsForCriticalSectionOnSecondSignal(t *testing.T) {
+	incoming := make(chan os.Signal, 2)
+	exited := make(chan int, 1)
+	ctx, cleanup := setupSignalContext(context.Background(), incoming, func(code int) { exited <- code }, criticalSectionDrainTimeout)
+	defer cleanup()
+
+	leaveCriticalSection := enterCriticalSection()
+
+	incoming <- os.Interrupt
+	waitForContextDone(t, ctx, time.Second)
+	incoming <- os.Interrupt
+
+	select {
+	case code := <-exited:
+		t.Fatalf("expected the exit to wait for the in-progress critical section, got exit code %d", code)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	leaveCriticalSection()
+
+	select {
+	case code := <-exited:
+		if code != 1 {
+			t.Errorf("unexpected exit code %d, expected 1", code)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected the exit to proceed once the critical section finished")
+	}
+}
+
+// TestSetupSignalContextRespectsCustomDrainTimeout exercises WithCriticalSectionDrainTimeout:
+// a second signal must force the exit once the shorter, custom timeout elapses, without waiting
+// for the in-progress critical section to finish.
+func TestSetupSignalContextRespectsCustomDrainTimeout(t *testing.T) {
+	incoming := make(chan os.Signal, 2)
+	exited := make(chan int, 1)
+	ctx, cleanup := setupSignalContext(context.Background(), incoming, func(code int) { exited <- code }, 50*time.Millisecond)
+	defer cleanup()
+
+	defer enterCriticalSection()()
+
+	incoming <- os.Interrupt
+	waitForContextDone(t, ctx, time.Second)
+	incoming <- os.Interrupt
+
+	select {
+	case code := <-exited:
+		if code != 1 {
+			t.Errorf("unexpected exit code %d, expected 1", code)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected the shorter custom drain timeout to force an exit without waiting for the critical section")
+	}
+}
+
+func TestSetupSignalContextCleanupStopsReacting(t *testing.T) {
+	incoming := make(chan os.Signal, 2)
+	exited := make(chan int, 1)
+	ctx, cleanup := setupSignalContext(context.Background(), incoming, func(code int) { exited <- code }, criticalSectionDrainTimeout)
+	cleanup()
+
+	incoming <- os.Interrupt
+	select {
+	case <-ctx.Done():
+		t.Fatal("expected no reaction to a signal after cleanup")
+	case code := <-exited:
+		t.Fatalf("expected no exit after cleanup, got exit code %d", code)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
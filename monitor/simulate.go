@@ -0,0 +1,218 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/openshift/library-go/pkg/operator/resource/resourceread"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SimulationScenario describes a synthetic static-pod layout and health-check script for the
+// `simulate` subcommand to replay in a sandbox directory, so a fallback (or its absence) can be
+// demonstrated or investigated without a real kubelet or operand.
+type SimulationScenario struct {
+	// TargetName is the operand name used to build manifest file and directory names, e.g.
+	// "kube-apiserver". Defaults to "kube-apiserver".
+	TargetName string `json:"targetName"`
+
+	// Revisions lists every revision to seed under the sandbox's static pod resources
+	// directory, e.g. [8, 9, 10].
+	Revisions []int `json:"revisions"`
+
+	// CurrentRevision is the revision the simulated root manifest points at, and the
+	// revision the monitor is configured to guard. It must be one of Revisions.
+	CurrentRevision int `json:"currentRevision"`
+
+	// ProbeOutcomes scripts the health check function's return value across successive probe
+	// ticks, in order. Once exhausted, the last outcome repeats for the remainder of the run;
+	// an empty list behaves as an unhealthy target throughout.
+	ProbeOutcomes []bool `json:"probeOutcomes"`
+
+	// ProbeInterval overrides the monitor's probe interval, e.g. "1s". Defaults to 1s.
+	ProbeInterval metav1.Duration `json:"probeInterval"`
+
+	// Timeout overrides the monitor's fallback timeout, e.g. "10s". Defaults to 10s, short
+	// enough for a scenario to reach a fallback decision without a long wait.
+	Timeout metav1.Duration `json:"timeout"`
+
+	// Duration bounds how long the simulation runs before the monitor is stopped and its
+	// final status reported, e.g. "30s". Defaults to Timeout plus 5 probe intervals, generous
+	// enough for the fallback decision, if any, to complete.
+	Duration metav1.Duration `json:"duration"`
+}
+
+// applyDefaults fills in zero-valued fields of s with the defaults documented on
+// SimulationScenario.
+func (s *SimulationScenario) applyDefaults() {
+	if s.TargetName == "" {
+		s.TargetName = "kube-apiserver"
+	}
+	if s.ProbeInterval.Duration == 0 {
+		s.ProbeInterval.Duration = time.Second
+	}
+	if s.Timeout.Duration == 0 {
+		s.Timeout.Duration = 10 * time.Second
+	}
+	if s.Duration.Duration == 0 {
+		s.Duration.Duration = s.Timeout.Duration + 5*s.ProbeInterval.Duration
+	}
+}
+
+// LoadSimulationScenario reads and parses a SimulationScenario from a YAML or JSON file at
+// path, applying its documented defaults to any zero-valued field.
+func LoadSimulationScenario(path string) (*SimulationScenario, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read scenario %q: %v", path, err)
+	}
+	scenario := &SimulationScenario{}
+	if err := yaml.UnmarshalStrict(raw, scenario); err != nil {
+		return nil, fmt.Errorf("failed to parse scenario %q: %v", path, err)
+	}
+	scenario.applyDefaults()
+	return scenario, nil
+}
+
+// scriptedHealthCheck returns a HealthChecker that replays outcomes in order across successive
+// calls, repeating the last outcome once outcomes is exhausted. It is safe for concurrent use,
+// since the monitor's health probe runs on its own goroutine.
+func scriptedHealthCheck(outcomes []bool) HealthChecker {
+	var lock sync.Mutex
+	next := 0
+	return HealthCheckerFunc(func(ctx context.Context) (bool, string, error) {
+		lock.Lock()
+		defer lock.Unlock()
+		if len(outcomes) == 0 {
+			return false, "scripted outcomes exhausted", nil
+		}
+		if next < len(outcomes) {
+			outcome := outcomes[next]
+			next++
+			return outcome, "", nil
+		}
+		return outcomes[len(outcomes)-1], "", nil
+	})
+}
+
+// buildSimulationLayout seeds sandboxDir with a manifests directory and a static pod resources
+// directory populated per scenario, mirroring the on-disk shape the kubelet and installer
+// maintain in production, and returns the resulting manifestsPath and staticPodResourcesPath.
+func buildSimulationLayout(scenario *SimulationScenario, sandboxDir string) (manifestsPath, staticPodResourcesPath string, err error) {
+	manifestsPath = path.Join(sandboxDir, "manifests")
+	staticPodResourcesPath = path.Join(sandboxDir, "static-pod-resources")
+	if err := os.MkdirAll(manifestsPath, 0755); err != nil {
+		return "", "", fmt.Errorf("failed to create %q: %v", manifestsPath, err)
+	}
+	if err := os.MkdirAll(staticPodResourcesPath, 0755); err != nil {
+		return "", "", fmt.Errorf("failed to create %q: %v", staticPodResourcesPath, err)
+	}
+
+	layout := openshiftLayout{}
+	for _, revision := range scenario.Revisions {
+		manifestPath := layout.TargetManifestPathFor(staticPodResourcesPath, scenario.TargetName, revision)
+		if err := os.MkdirAll(path.Dir(manifestPath), 0755); err != nil {
+			return "", "", fmt.Errorf("failed to create the revision %d directory: %v", revision, err)
+		}
+		if err := os.WriteFile(manifestPath, simulationPodManifest(scenario.TargetName, revision), 0644); err != nil {
+			return "", "", fmt.Errorf("failed to write the revision %d manifest: %v", revision, err)
+		}
+	}
+
+	rootManifestPath := layout.RootManifestPath(manifestsPath, scenario.TargetName)
+	if err := os.WriteFile(rootManifestPath, simulationPodManifest(scenario.TargetName, scenario.CurrentRevision), 0644); err != nil {
+		return "", "", fmt.Errorf("failed to write the root manifest: %v", err)
+	}
+
+	return manifestsPath, staticPodResourcesPath, nil
+}
+
+// simulationPodManifest renders a minimal static pod manifest for targetName carrying the
+// given revision label, matching what revisionOfPod expects to find.
+func simulationPodManifest(targetName string, revision int) []byte {
+	pod := &corev1.Pod{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   targetName,
+			Labels: map[string]string{"revision": strconv.Itoa(revision)},
+		},
+	}
+	return []byte(resourceread.WritePodV1OrDie(pod))
+}
+
+// RunSimulation seeds sandboxDir with scenario's static-pod layout and runs a StartupMonitor
+// against it for scenario.Duration, driven by a health check scripted from
+// scenario.ProbeOutcomes, and returns the monitor's final status.
+func RunSimulation(scenario *SimulationScenario, sandboxDir string) (Status, error) {
+	scenario.applyDefaults()
+
+	manifestsPath, staticPodResourcesPath, err := buildSimulationLayout(scenario, sandboxDir)
+	if err != nil {
+		return Status{}, err
+	}
+
+	sm := New(scriptedHealthCheck(scenario.ProbeOutcomes)).
+		WithProbeInterval(scenario.ProbeInterval.Duration).
+		WithProbeTimeout(scenario.Timeout.Duration)
+	sm.targetName = scenario.TargetName
+	sm.manifestsPath = manifestsPath
+	sm.staticPodResourcesPath = staticPodResourcesPath
+	sm.revision = scenario.CurrentRevision
+
+	ctx, cancel := context.WithTimeout(context.Background(), scenario.Duration.Duration)
+	defer cancel()
+	sm.Run(ctx)
+
+	return sm.GetStatus(), nil
+}
+
+// newSimulateCommand builds the "simulate" subcommand, which replays a SimulationScenario
+// against a sandbox directory for demos and regression investigation, without a real kubelet
+// or operand.
+func newSimulateCommand() *cobra.Command {
+	var scenarioPath string
+	var sandboxDir string
+
+	cmd := &cobra.Command{
+		Use:   "simulate",
+		Short: "Replays a scenario describing a synthetic static-pod layout and probe outcomes against a sandbox directory.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			scenario, err := LoadSimulationScenario(scenarioPath)
+			if err != nil {
+				return err
+			}
+
+			if sandboxDir == "" {
+				sandboxDir, err = os.MkdirTemp("", "startup-monitor-simulate-")
+				if err != nil {
+					return fmt.Errorf("failed to create a sandbox directory: %v", err)
+				}
+			}
+			fmt.Printf("Simulating in %s\n", sandboxDir)
+
+			status, err := RunSimulation(scenario, sandboxDir)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Final status: phase=%s revision=%d lastProbeHealthy=%t consecutiveSyncErrors=%d lastError=%v\n",
+				status.Phase, status.Revision, status.LastProbeHealthy, status.ConsecutiveSyncErrors, status.LastError)
+			return nil
+		},
+	}
+
+	fs := cmd.Flags()
+	fs.StringVar(&scenarioPath, "scenario", "", "path to a YAML or JSON file describing the scenario to replay (required)")
+	fs.StringVar(&sandboxDir, "sandbox-dir", "", "directory to build the synthetic static-pod layout in; defaults to a fresh temp directory, printed on start and left in place for inspection")
+	_ = cmd.MarkFlagRequired("scenario")
+
+	return cmd
+}
@@ -0,0 +1,72 @@
+package monitor
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestSimulationScenarioApplyDefaults(t *testing.T) {
+	scenario := &SimulationScenario{}
+	scenario.applyDefaults()
+
+	if scenario.TargetName != "kube-apiserver" {
+		t.Errorf("unexpected default TargetName %q", scenario.TargetName)
+	}
+	if scenario.ProbeInterval.Duration != time.Second {
+		t.Errorf("unexpected default ProbeInterval %s", scenario.ProbeInterval.Duration)
+	}
+	if scenario.Timeout.Duration != 10*time.Second {
+		t.Errorf("unexpected default Timeout %s", scenario.Timeout.Duration)
+	}
+	if expected := scenario.Timeout.Duration + 5*scenario.ProbeInterval.Duration; scenario.Duration.Duration != expected {
+		t.Errorf("unexpected default Duration %s, expected %s", scenario.Duration.Duration, expected)
+	}
+}
+
+func TestSimulationScenarioApplyDefaultsPreservesSetFields(t *testing.T) {
+	scenario := &SimulationScenario{TargetName: "etcd"}
+	scenario.ProbeInterval.Duration = 2 * time.Second
+	scenario.Timeout.Duration = 5 * time.Second
+	scenario.Duration.Duration = 30 * time.Second
+	scenario.applyDefaults()
+
+	if scenario.TargetName != "etcd" {
+		t.Errorf("expected TargetName to be preserved, got %q", scenario.TargetName)
+	}
+	if scenario.ProbeInterval.Duration != 2*time.Second {
+		t.Errorf("expected ProbeInterval to be preserved, got %s", scenario.ProbeInterval.Duration)
+	}
+	if scenario.Timeout.Duration != 5*time.Second {
+		t.Errorf("expected Timeout to be preserved, got %s", scenario.Timeout.Duration)
+	}
+	if scenario.Duration.Duration != 30*time.Second {
+		t.Errorf("expected Duration to be preserved, got %s", scenario.Duration.Duration)
+	}
+}
+
+func TestScriptedHealthCheck(t *testing.T) {
+	check := scriptedHealthCheck([]bool{true, false, true})
+
+	var got []bool
+	for i := 0; i < 5; i++ {
+		healthy, _, err := check.Check(context.Background())
+		if err != nil {
+			t.Fatalf("call %d: unexpected error %v", i, err)
+		}
+		got = append(got, healthy)
+	}
+	expected := []bool{true, false, true, true, true}
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("call %d: got %t, expected %t", i, got[i], expected[i])
+		}
+	}
+}
+
+func TestScriptedHealthCheckEmptyOutcomes(t *testing.T) {
+	check := scriptedHealthCheck(nil)
+	if healthy, _, err := check.Check(context.Background()); healthy || err != nil {
+		t.Errorf("expected an empty outcomes list to report unhealthy, got healthy=%t err=%v", healthy, err)
+	}
+}
@@ -0,0 +1,109 @@
+package monitor
+
+import (
+	"fmt"
+	"path"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+
+	"github.com/openshift/library-go/pkg/operator/resource/resourceread"
+)
+
+// snapshotCurrentManifestIfNeeded preserves the manifest currently being monitored, and any
+// host path files it references, under sm.layout's revisioned path, for layouts (see
+// Layout.RequiresSelfManagedSnapshots) whose installer doesn't already leave a revisioned copy
+// on disk. It is idempotent: once a revision has been snapshotted, later calls for the same
+// revision do nothing, so it is cheap to call on every sync iteration.
+func (sm *StartupMonitor) snapshotCurrentManifestIfNeeded() error {
+	if !sm.layout.RequiresSelfManagedSnapshots() {
+		return nil
+	}
+
+	snapshotPath := sm.targetManifestPathFor(sm.revision)
+	if exists, err := sm.fileExists(snapshotPath); err != nil {
+		return err
+	} else if exists {
+		return nil
+	}
+
+	currentPod, err := sm.readRootTargetPodCached()
+	if err != nil {
+		return err
+	}
+
+	klog.Infof("Snapshotting the manifest for %s revision %d before monitoring it", sm.targetName, sm.revision)
+	snapshotBytes := []byte(resourceread.WritePodV1OrDie(currentPod))
+	if err := retryOnTransientError(func() error { return sm.io.WriteFileAtomic(snapshotPath, snapshotBytes, 0644) }); err != nil {
+		return err
+	}
+
+	sm.snapshotHostPathVolumes(currentPod, sm.revision)
+	return nil
+}
+
+// hostPathSnapshotPathFor returns the path a host path volume's contents are snapshotted to
+// (or restored from) for the given revision.
+func (sm *StartupMonitor) hostPathSnapshotPathFor(revision int, volumeName string) string {
+	return path.Join(sm.staticPodResourcesPath, fmt.Sprintf("%s.yaml.%d.hostpath-%s", sm.targetName, revision, volumeName))
+}
+
+// snapshotHostPathVolumes preserves the contents of every regular-file host path volume pod
+// references, so restoreHostPathVolumes can put them back on a later rollback to revision.
+// Directory host paths are skipped: the manifest and the file-backed configuration it depends
+// on are what a rollback actually needs restored, and IOInterface has no recursive directory
+// copy. This is a best-effort, additional signal: a failure to preserve one volume must not
+// block snapshotting the manifest itself.
+func (sm *StartupMonitor) snapshotHostPathVolumes(pod *corev1.Pod, revision int) {
+	for _, volume := range pod.Spec.Volumes {
+		if volume.HostPath == nil {
+			continue
+		}
+
+		info, err := sm.io.Stat(volume.HostPath.Path)
+		if err != nil {
+			klog.Warningf("Unable to stat host path volume %q (%s) for %s revision %d, skipping its snapshot: %v", volume.HostPath.Path, volume.Name, sm.targetName, revision, err)
+			continue
+		}
+		if info.IsDir() {
+			continue
+		}
+
+		data, err := sm.io.ReadFile(volume.HostPath.Path)
+		if err != nil {
+			klog.Warningf("Unable to read host path volume %q (%s) for %s revision %d, skipping its snapshot: %v", volume.HostPath.Path, volume.Name, sm.targetName, revision, err)
+			continue
+		}
+		if err := retryOnTransientError(func() error { return sm.io.WriteFile(sm.hostPathSnapshotPathFor(revision, volume.Name), data, 0644) }); err != nil {
+			klog.Warningf("Unable to snapshot host path volume %q (%s) for %s revision %d: %v", volume.HostPath.Path, volume.Name, sm.targetName, revision, err)
+		}
+	}
+}
+
+// restoreHostPathVolumes writes back the snapshotted contents of every regular-file host path
+// volume pod (at the given, now-restored revision) references, so a rollback restores not just
+// the manifest but the files it depends on. Volumes with no snapshot on disk are left alone:
+// this is a best-effort, additional signal, and a missing snapshot must not block the fallback
+// it would otherwise complete.
+func (sm *StartupMonitor) restoreHostPathVolumes(pod *corev1.Pod, revision int) {
+	for _, volume := range pod.Spec.Volumes {
+		if volume.HostPath == nil {
+			continue
+		}
+
+		snapshotPath := sm.hostPathSnapshotPathFor(revision, volume.Name)
+		exists, err := sm.fileExists(snapshotPath)
+		if err != nil || !exists {
+			continue
+		}
+
+		data, err := sm.io.ReadFile(snapshotPath)
+		if err != nil {
+			klog.Warningf("Unable to read the snapshot of host path volume %q (%s) for %s revision %d: %v", volume.HostPath.Path, volume.Name, sm.targetName, revision, err)
+			continue
+		}
+		if err := retryOnTransientError(func() error { return sm.io.WriteFile(volume.HostPath.Path, data, 0644) }); err != nil {
+			klog.Warningf("Unable to restore host path volume %q (%s) for %s revision %d: %v", volume.HostPath.Path, volume.Name, sm.targetName, revision, err)
+		}
+	}
+}
@@ -5,16 +5,23 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"runtime/debug"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/openshift/library-go/pkg/operator/resource/resourceread"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/clock"
 	"k8s.io/apimachinery/pkg/util/uuid"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/klog/v2"
 )
 
@@ -33,6 +40,11 @@ type StartupMonitor struct {
 	// timeout specifies a timeout after which the monitor starts the fall back procedure
 	timeout time.Duration
 
+	// singleNodeTimeout overrides timeout when the cluster's control plane topology is
+	// detected as SingleReplica (SNO), since a reboot and etcd re-init on a single node take
+	// far longer than the HA default budget affords.
+	singleNodeTimeout time.Duration
+
 	// revision at which the monitor was started
 	revision int
 
@@ -40,45 +52,665 @@ type StartupMonitor struct {
 	// used to construct the final file name when reading the current and previous manifests
 	targetName string
 
+	// targetNamespace is the namespace the operand's static pod (and its mirror pod on the
+	// API server) runs in. It is only required when client is set.
+	targetNamespace string
+
 	// manifestsPath points to the directory that holds the root manifests
 	manifestsPath string
 
 	// staticPodResourcesPath points to the directory that holds revisioned manifests
 	staticPodResourcesPath string
 
-	// isTargetHealthy defines a function that abstracts away assessing operand's health condition.
-	// the provided functions should be async and cheap in a sense that it shouldn't assess the target
-	// only read the current state.
+	// healthChecker abstracts away assessing operand's health condition. Check should be async
+	// and cheap in a sense that it shouldn't assess the target, only read the current state,
 	// mainly because we acquire a lock on each sync.
-	isTargetHealthy func() bool
+	healthChecker HealthChecker
+
+	// lock protects monitorTimeStamp and phase below, so that a status endpoint or hook
+	// running on a different goroutine can safely read them while the sync loop is writing.
+	lock sync.Mutex
 
 	// records the time the monitor has started assessing operand's health condition
 	monitorTimeStamp time.Time
 
+	// phase reflects the monitor's most recent assessment of the target
+	phase Phase
+
+	// lastProbeHealthy records the result of the most recent call to healthChecker.Check
+	lastProbeHealthy bool
+
+	// lastErr records the error returned by the most recent sync, if any
+	lastErr error
+
+	// probeCount counts how many times the health checker has been called so far, for the
+	// state file (see writeStateFile).
+	probeCount int
+
+	// lastProbeErr records the error returned by the most recent health checker call, if any,
+	// for the state file (see writeStateFile).
+	lastProbeErr string
+
+	// lastSyncCompletion records when the most recent sync iteration finished, whether it
+	// errored, succeeded or panicked. runWatchdogLoop reads it, from its own goroutine, to
+	// tell an idle-but-alive loop apart from one wedged on I/O.
+	lastSyncCompletion time.Time
+
+	// consecutiveSyncErrors counts sync iterations that have returned an error since the last
+	// one that didn't, so a status endpoint can distinguish an occasional transient error from
+	// a monitor that is erroring continuously instead of actually monitoring.
+	consecutiveSyncErrors int
+
+	// fallbackMetadataFuncs are invoked at fallback time to compute extra annotations/labels
+	// applied to the fallback pod, in addition to the built-in fallback-for-revision annotation.
+	fallbackMetadataFuncs []FallbackMetadataFunc
+
+	// fallbackGuards are consulted before falling back to the previous revision. Any guard
+	// that vetoes the fallback aborts the procedure and reports a degraded status instead,
+	// without touching the on-disk manifests. This lets an operand-specific operator (e.g.
+	// etcd, which must not fall back below quorum) plug in its own precondition without this
+	// generic package depending on the operand's client.
+	fallbackGuards []FallbackGuardFunc
+
 	// io collects file system level operations that need to be mocked out during tests
-	io ioInterface
+	io IOInterface
+
+	// clock abstracts reading the current time so tests can step simulated time across the
+	// fallback deadline, stabilization window, and holdoff periods deterministically instead
+	// of sleeping for real. Defaults to clock.RealClock{}.
+	clock clock.PassiveClock
+
+	// layout abstracts the directory scheme used to locate root and revisioned manifests.
+	// defaults to openshiftLayout.
+	layout Layout
+
+	// uidProvider supplies the UID assigned to the fallback pod. Defaults to uuid.NewUUID.
+	uidProvider UIDProviderFunc
+
+	// sleepInsteadOfSelfRemoval, once the target is observed healthy, makes the monitor idle
+	// in a low-cost sleep state (no further health probing, no further logging beyond the
+	// transition itself) instead of removing its own manifest, so its logs and status
+	// endpoint remain available for inspection for the rest of the revision's lifetime. See
+	// WithSleepMode.
+	sleepInsteadOfSelfRemoval bool
+
+	// client is an optional Kubernetes client. When set, it unlocks API-backed features
+	// (events, status updates, node checks). The monitor remains fully functional without
+	// one, falling back to reading and writing local static pod manifests only.
+	client kubernetes.Interface
+
+	// dynamicClient is an optional dynamic client used to patch operatorResource with a
+	// fallback condition. Both it and operatorResource must be set to report the condition.
+	dynamicClient dynamic.Interface
+
+	// operatorResource identifies the singleton operator.openshift.io resource (e.g.
+	// kubeapiservers.operator.openshift.io) to patch with a fallback condition, and to read
+	// the Progressing condition from before falling back.
+	operatorResource schema.GroupVersionResource
+
+	// reportNodeState enables patching this node's object with a JSON-encoded NodeState
+	// annotation as the monitor's assessment of the target changes, for a cluster operator
+	// that wants to surface a Degraded condition from Node objects alone. Requires client to
+	// be set. Defaults to false. Set via WithNodeStateReporting. See patchNodeState.
+	reportNodeState bool
+
+	// stateFileDir, if set, enables writing a machine-readable JSON state file describing the
+	// monitor's current state for its target into this directory on every phase transition
+	// (monitoring started, target succeeded, fallback performed), so the installer and pruner
+	// can decide whether a revision rollout failed without depending on a Kubernetes client.
+	// The directory must already exist. Left empty, the default, no file is written. Set via
+	// WithStateFileDir. See writeStateFile.
+	stateFileDir string
+
+	// annotationPrefix overrides the annotation domain prefix applied to the fallback pod's
+	// manifest, e.g. "fallback-for-revision" becomes "<annotationPrefix>/fallback-for-revision".
+	// Left empty, the default, defaultAnnotationPrefix is used. Set via WithAnnotationPrefix.
+	// See annotationKey.
+	annotationPrefix string
+
+	// terminationMessagePath is where the watchdog writes a concise summary of a fatal exit,
+	// so `kubectl describe pod` surfaces why the monitor's pod terminated without needing log
+	// access. Left empty, no termination message is written. Set via
+	// WithTerminationMessagePath. See writeTerminationMessage.
+	terminationMessagePath string
+
+	// servingCertFile and servingKeyFile point to the target's serving certificate/key pair
+	// on disk, checked as an additional health signal alongside healthChecker. Both must be
+	// set to enable the check; left empty, it is skipped.
+	servingCertFile string
+	servingKeyFile  string
+
+	// criSocket points to the container runtime's CRI socket, used to fetch exit codes and
+	// termination reasons for the target's containers at fallback time. Left empty, this
+	// diagnostic is skipped.
+	criSocket string
+
+	// bootstrapAPIServerEndpoint is the address of the temporary bootstrap apiserver used
+	// during cluster installation, checked alongside the other masters' apiservers in
+	// anyPeerAPIServerHealthy. It lets the monitor tell "my local revision is broken" apart
+	// from "the whole control plane isn't up yet" before any other master is reachable. Left
+	// empty, only the other masters are consulted.
+	bootstrapAPIServerEndpoint string
+
+	// maxRolloutExtensions bounds how many times a timed-out deadline may be extended while
+	// the cluster-wide rollout is still Progressing, so a rollout that never completes can't
+	// defer a fallback forever.
+	maxRolloutExtensions int
+
+	// successThreshold is how many consecutive healthy probes are required before the target
+	// is declared healthy, so a single flaky 200 doesn't immediately delete the monitor.
+	// Defaults to 1, matching the monitor's original behavior. Set via WithSuccessThreshold.
+	successThreshold int
+
+	// failureThreshold is how many consecutive unhealthy probes are required before a timed-out
+	// deadline actually triggers a fallback, so a single transient failure landing right on the
+	// deadline doesn't roll back a target that's otherwise been healthy. Defaults to 1,
+	// matching the monitor's original behavior. Set via WithFailureThreshold.
+	failureThreshold int
+
+	// consecutiveSuccesses and consecutiveFailures count the current streak of same-outcome
+	// probe results, reset by the other on the first opposite result. Only sync reads or
+	// writes them, so unlike the counters in Status they need no locking.
+	consecutiveSuccesses int
+	consecutiveFailures  int
+
+	// blacklistedRevisions holds revisions that must never be selected as a fallback target,
+	// even if their resource directory is present and otherwise well-formed, e.g. because a
+	// caller already knows the revision was itself the subject of a previous, unsuccessful
+	// fallback. Set via WithBlacklistedRevisions.
+	blacklistedRevisions map[int]bool
+
+	// lastKnownGoodHistorySize bounds how many proven-good revisions the monitor keeps a
+	// pointer for, beyond the current one, so a fallback has more safe, already-vetted targets
+	// to try if the current pointer is missing or its revision is blacklisted. Defaults to 1,
+	// i.e. only the current pointer, matching the monitor's original behavior. Set via
+	// WithLastKnownGoodHistorySize.
+	lastKnownGoodHistorySize int
+
+	// knownGoodSnapshotRetention bounds how many revisions the known-good snapshot manager
+	// keeps an archived manifest (and its manifest-declared dependent files) for. Unlike
+	// lastKnownGoodHistorySize, which points at manifests an installer-style Layout already
+	// keeps around, this archive is self-contained and layout-independent: it is 0, disabled,
+	// by default, since the monitor's original behavior didn't archive anything. Set via
+	// WithKnownGoodSnapshotRetention. See archiveKnownGoodSnapshot.
+	knownGoodSnapshotRetention int
+
+	// verifyDependentFiles enables checking, at fallback time, that every dependent file the
+	// revision being restored declares via dependentFilesAnnotation is actually present on disk,
+	// repairing it from the known-good snapshot archive (see WithKnownGoodSnapshotRetention) when
+	// it is missing and an archived copy is available. Without it, a fallback may write a pod
+	// manifest that references a configmap or secret file an installer failed to leave behind for
+	// that revision. Defaults to false. Set via WithDependentFileVerification.
+	verifyDependentFiles bool
+
+	// observationModeRevisions bounds how many revisions, starting from the first one the
+	// monitor is deployed to guard, run the full detection pipeline without ever actually
+	// falling back: performFallback still runs every check, gathers diagnostics and writes the
+	// failed-verdict marker as usual, but stops short of touching the on-disk manifests,
+	// reporting FallbackObservedConditionType instead. This lets a cluster administrator adopt
+	// the monitor on a production control plane and see what it would have done before trusting
+	// it to act. 0, the default, disables observation mode entirely. Set via
+	// WithObservationMode. See observationModeActive.
+	observationModeRevisions int
+
+	// pinnedFallbackRevision, if set, overrides fallbackToPreviousRevision's usual last-known-
+	// good/N-1 heuristic and forces the fallback to use exactly this revision instead, so an
+	// admin who already knows which revision was last good during incident response doesn't
+	// have to trust findPreviousRevision to land on it. The revision must have a manifest on
+	// disk; fallbackToPreviousRevision returns an error otherwise. 0, the default, disables the
+	// override. Set via WithPinnedFallbackRevision.
+	pinnedFallbackRevision int
+
+	// installerLock, if set, is acquired for the remainder of sync before it reads the root
+	// manifest or performs a fallback, and released once sync returns, so the monitor never
+	// races a concurrent installer pod that is in the middle of writing a new revision: without
+	// it, an installer could write its new manifest just as the monitor decides to fall back to
+	// the previous one, and the two writes would stomp each other. Left nil, the default, no
+	// locking is performed. Set via WithInstallerLockFilePath.
+	installerLock *FLock
+
+	// installerLockTimeout bounds how long sync waits to acquire installerLock before giving up
+	// and returning a SyncErrorCategoryInstallerLock error for that tick. Only meaningful when
+	// installerLock is set. Set via WithInstallerLockTimeout.
+	installerLockTimeout time.Duration
+
+	// rolloutExtensions counts how many times the deadline has been extended so far because
+	// of an ongoing cluster-wide rollout. Guarded by lock.
+	rolloutExtensions int
+
+	// lastSyncTimeStamp records the wall-clock time of the previous sync tick, used by
+	// detectClockJump to notice a wall-clock jump between ticks. It does not participate in
+	// deadline math, which is anchored to monitorTimeStamp's monotonic reading instead.
+	lastSyncTimeStamp time.Time
+
+	// rootManifestCache holds the parsed root manifest from the most recent sync tick, along
+	// with the file identity it was parsed from, so an unchanged root manifest doesn't have to
+	// be re-read and re-decoded on every sync. Only used by loadRootTargetPodAndExtractRevision,
+	// which is on the hot path (once per probeInterval); the other, much rarer readers of a pod
+	// manifest go straight through readTargetPod.
+	rootManifestCache struct {
+		identity fileIdentity
+		pod      *corev1.Pod
+	}
+
+	// probeCache holds the most recently published result of calling healthChecker.Check, kept
+	// up to date by a dedicated goroutine started from Run. sync reads it instead of calling
+	// healthChecker itself, so a slow or hung probe can never stall the sync loop.
+	probeCache healthProbeCache
+
+	// supersededGracePeriod bounds how long the monitor keeps idling once it observes that the
+	// revision it guards has been superseded by a newer one. Once exceeded, the monitor removes
+	// its own manifest and lets the kubelet stop it, instead of idling forever as a zombie
+	// static pod that the installer forgot to clean up.
+	supersededGracePeriod time.Duration
+
+	// supersededSince records when the monitor first observed that its revision was superseded,
+	// or the zero value if it currently isn't. Only read and written from sync, like
+	// lastSyncTimeStamp and rootManifestCache above.
+	supersededSince time.Time
+
+	// dedupLog collapses lines sync logs repeatedly, identically, tick after tick (e.g. while
+	// waiting out a fallback countdown at a 1s probe interval) into a single line with a
+	// repeat count, keeping the node journal readable.
+	dedupLog dedupLogger
+
+	// previousRevisionCache holds the previous-revision result from the most recent call to
+	// findPreviousRevision, along with the static pod resources directory's identity at that
+	// time, so a fallback that retries several times against an unchanged directory of dozens
+	// of revisions doesn't rescan it on every attempt.
+	previousRevisionCache struct {
+		populated bool
+		identity  fileIdentity
+		revision  int
+		found     bool
+	}
+
+	// done is closed once a monitor started with Start has returned from Run, letting an
+	// embedding operator select on it instead of blocking on Run directly. See Start and Done.
+	done chan struct{}
+
+	// cancelStart cancels the context passed to Run by a monitor started with Start, so Stop
+	// can request an early, graceful shutdown without the caller having to hold onto that
+	// context itself.
+	cancelStart context.CancelFunc
+
+	// startErr records the error Run returned for a monitor started with Start, valid once
+	// done is closed.
+	startErr error
+}
+
+// FallbackMetadataFunc computes extra annotations and labels to apply to the fallback pod
+// at fallback time, for the revision being rolled back from. Either return value may be nil.
+type FallbackMetadataFunc func(revision int) (annotations, labels map[string]string)
+
+// FallbackGuardFunc is consulted before the monitor falls back to the previous revision. It
+// reports whether the fallback is allowed to proceed and, when it isn't, a human-readable
+// reason to surface on the degraded status and operator condition.
+type FallbackGuardFunc func() (allowed bool, reason string)
+
+// UIDProviderFunc supplies the UID assigned to the fallback pod. It defaults to uuid.NewUUID;
+// set via WithUIDProvider, an embedder may substitute a stable or traceable UID instead (e.g.
+// derived from the revision), and a test may substitute a deterministic one to assert against
+// exact output manifests.
+type UIDProviderFunc func() types.UID
+
+// Status is a point-in-time, read-only snapshot of the monitor's state, returned by GetStatus.
+type Status struct {
+	// Phase is the monitor's most recent assessment of the target
+	Phase Phase
+
+	// Revision is the revision the monitor is guarding
+	Revision int
+
+	// Deadline is the time by which the target must become healthy before the monitor
+	// falls back to the previous revision. It is the zero value until the monitor
+	// starts assessing the target's health.
+	Deadline time.Time
+
+	// LastProbeHealthy is the result of the most recent call to the health check function
+	LastProbeHealthy bool
+
+	// LastError is the error returned by the most recent sync, if any
+	LastError error
+
+	// ConsecutiveSyncErrors counts sync iterations that have returned an error since the last
+	// one that didn't.
+	ConsecutiveSyncErrors int
+}
+
+// GetStatus returns a snapshot of the monitor's current state. It is safe for concurrent use
+// and can be called by embedding operators or an HTTP status endpoint while Run is in progress.
+func (sm *StartupMonitor) GetStatus() Status {
+	sm.lock.Lock()
+	defer sm.lock.Unlock()
+
+	var deadline time.Time
+	if !sm.monitorTimeStamp.IsZero() {
+		deadline = sm.monitorTimeStamp.Add(sm.timeout)
+	}
+
+	return Status{
+		Phase:                 sm.phase,
+		Revision:              sm.revision,
+		Deadline:              deadline,
+		LastProbeHealthy:      sm.lastProbeHealthy,
+		LastError:             sm.lastErr,
+		ConsecutiveSyncErrors: sm.consecutiveSyncErrors,
+	}
+}
+
+// recordProbeResult stores the result of the most recent health probe. It is safe for concurrent use.
+func (sm *StartupMonitor) recordProbeResult(healthy bool) {
+	sm.lock.Lock()
+	defer sm.lock.Unlock()
+	sm.lastProbeHealthy = healthy
+}
+
+// recordProbeAttempt counts a health checker call and records its error, if any, for the state
+// file (see writeStateFile). It is safe for concurrent use.
+func (sm *StartupMonitor) recordProbeAttempt(err error) {
+	sm.lock.Lock()
+	defer sm.lock.Unlock()
+	sm.probeCount++
+	if err != nil {
+		sm.lastProbeErr = err.Error()
+	} else {
+		sm.lastProbeErr = ""
+	}
 }
 
-func New(isTargetHealthy func() bool) *StartupMonitor {
-	return &StartupMonitor{isTargetHealthy: isTargetHealthy, io: realFS{}}
+// recordSyncError stores the error returned by the most recent sync, if any, and maintains
+// consecutiveSyncErrors. It is safe for concurrent use.
+func (sm *StartupMonitor) recordSyncError(err error) {
+	sm.lock.Lock()
+	defer sm.lock.Unlock()
+	sm.lastErr = err
+	if err != nil {
+		sm.consecutiveSyncErrors++
+	} else {
+		sm.consecutiveSyncErrors = 0
+	}
+}
+
+// recordSyncCompletion timestamps the most recently completed sync iteration, so
+// runWatchdogLoop can tell an idle-but-alive loop apart from one wedged on I/O. It is safe for
+// concurrent use.
+func (sm *StartupMonitor) recordSyncCompletion() {
+	sm.lock.Lock()
+	defer sm.lock.Unlock()
+	sm.lastSyncCompletion = time.Now()
+}
+
+// syncCompletionAge reports how long it has been since the most recently completed sync
+// iteration, and whether one has completed yet. It is safe for concurrent use.
+func (sm *StartupMonitor) syncCompletionAge() (age time.Duration, completed bool) {
+	sm.lock.Lock()
+	defer sm.lock.Unlock()
+	if sm.lastSyncCompletion.IsZero() {
+		return 0, false
+	}
+	return time.Since(sm.lastSyncCompletion), true
+}
+
+// Phase reflects the current stage of the startup monitor's sync loop.
+type Phase string
+
+const (
+	// PhasePending means the monitor hasn't reached a verdict about the target yet.
+	PhasePending Phase = "Pending"
+	// PhaseHealthy means the target was observed healthy and a last known good revision was recorded.
+	PhaseHealthy Phase = "Healthy"
+	// PhaseFallback means the target timed out and the monitor fell back to the previous revision.
+	PhaseFallback Phase = "Fallback"
+	// PhaseDegraded means a fallback was warranted but a FallbackGuardFunc vetoed it, so the
+	// monitor left the manifests untouched and is reporting a degraded status instead.
+	PhaseDegraded Phase = "Degraded"
+)
+
+// FallbackReason identifies why the monitor decided to fall back to the previous revision. It
+// is reported as the Reason of the operator fallback condition, and is exported so monitoring
+// rules and operator code can match on it reliably across versions.
+type FallbackReason string
+
+const (
+	// ReasonTimeout means the target never became healthy within its fallback timeout.
+	ReasonTimeout FallbackReason = "Timeout"
+	// ReasonRevisionAbandoned means the installer controller already marked the guarded
+	// revision Failed or Abandoned cluster-wide.
+	ReasonRevisionAbandoned FallbackReason = "RevisionAbandoned"
+)
+
+func New(healthChecker HealthChecker) *StartupMonitor {
+	return &StartupMonitor{
+		healthChecker:            healthChecker,
+		done:                     make(chan struct{}),
+		io:                       realFS{},
+		clock:                    clock.RealClock{},
+		layout:                   openshiftLayout{},
+		uidProvider:              uuid.NewUUID,
+		maxRolloutExtensions:     defaultMaxRolloutExtensions,
+		singleNodeTimeout:        defaultSingleNodeTimeout,
+		supersededGracePeriod:    defaultSupersededGracePeriod,
+		lastKnownGoodHistorySize: defaultLastKnownGoodHistorySize,
+		installerLockTimeout:     defaultInstallerLockTimeout,
+		successThreshold:         defaultSuccessThreshold,
+		failureThreshold:         defaultFailureThreshold,
+	}
 }
 
-func (sm *StartupMonitor) Run(ctx context.Context) {
+// defaultMaxRolloutExtensions bounds how many times a timed-out deadline is extended while
+// the cluster-wide rollout is still Progressing.
+const defaultMaxRolloutExtensions = 3
+
+// defaultSingleNodeTimeout is the timeout applied when a SingleReplica control plane
+// topology is detected.
+const defaultSingleNodeTimeout = 20 * time.Minute
+
+// defaultInstallerLockTimeout bounds how long sync waits to acquire installerLock, when set,
+// before giving up on that tick. Comfortably above FLock.TryLock's documented 1 second floor.
+const defaultInstallerLockTimeout = 5 * time.Second
+
+// defaultSupersededGracePeriod bounds how long the monitor idles after observing that its
+// revision has been superseded before removing its own manifest and letting the kubelet stop it.
+const defaultSupersededGracePeriod = 10 * time.Minute
+
+// defaultLastKnownGoodHistorySize keeps only the current last known good pointer, matching the
+// monitor's original behavior; see WithLastKnownGoodHistorySize.
+const defaultLastKnownGoodHistorySize = 1
+
+// defaultSuccessThreshold and defaultFailureThreshold act on the very first probe result,
+// matching the monitor's original behavior; see WithSuccessThreshold and WithFailureThreshold.
+const (
+	defaultSuccessThreshold = 1
+	defaultFailureThreshold = 1
+)
+
+// loopJitterFactor is passed to wait.JitterUntil for the sync loop and the health probe loop,
+// so several operand monitors on the same node, all configured with the same probe interval,
+// don't perform their disk reads and probes in lockstep every tick.
+const loopJitterFactor = 0.2
+
+// NewFromConfig constructs a StartupMonitor from a validated Config.
+// This is the preferred way of constructing a monitor as it catches
+// misconfiguration up front instead of at some point during Run.
+func NewFromConfig(cfg *Config) *StartupMonitor {
+	installerLockTimeout := cfg.InstallerLockTimeout
+	if installerLockTimeout == 0 {
+		installerLockTimeout = defaultInstallerLockTimeout
+	}
+
+	sm := &StartupMonitor{
+		healthChecker:              cfg.IsTargetHealthy,
+		done:                       make(chan struct{}),
+		probeInterval:              cfg.ProbeInterval,
+		timeout:                    cfg.ProbeTimeout,
+		targetName:                 cfg.TargetName,
+		targetNamespace:            cfg.TargetNamespace,
+		manifestsPath:              cfg.ManifestsPath,
+		staticPodResourcesPath:     cfg.StaticPodResourcesPath,
+		revision:                   cfg.Revision,
+		io:                         realFS{},
+		clock:                      clock.RealClock{},
+		layout:                     openshiftLayout{},
+		uidProvider:                uuid.NewUUID,
+		client:                     cfg.Client,
+		dynamicClient:              cfg.DynamicClient,
+		operatorResource:           cfg.OperatorResource,
+		reportNodeState:            cfg.ReportNodeState,
+		stateFileDir:               cfg.StateFileDir,
+		terminationMessagePath:     cfg.TerminationMessagePath,
+		annotationPrefix:           cfg.AnnotationPrefix,
+		maxRolloutExtensions:       cfg.MaxRolloutExtensions,
+		singleNodeTimeout:          cfg.SingleNodeTimeout,
+		servingCertFile:            cfg.ServingCertFile,
+		servingKeyFile:             cfg.ServingKeyFile,
+		criSocket:                  cfg.CRISocket,
+		bootstrapAPIServerEndpoint: cfg.BootstrapAPIServerEndpoint,
+		supersededGracePeriod:      cfg.SupersededGracePeriod,
+		sleepInsteadOfSelfRemoval:  cfg.SleepMode,
+		lastKnownGoodHistorySize:   cfg.LastKnownGoodHistorySize,
+		knownGoodSnapshotRetention: cfg.KnownGoodSnapshotRetention,
+		verifyDependentFiles:       cfg.VerifyDependentFiles,
+		observationModeRevisions:   cfg.ObservationModeRevisions,
+		pinnedFallbackRevision:     cfg.PinnedFallbackRevision,
+		installerLockTimeout:       installerLockTimeout,
+		successThreshold:           cfg.SuccessThreshold,
+		failureThreshold:           cfg.FailureThreshold,
+	}
+	if cfg.InstallerLockFilePath != "" {
+		sm.installerLock = NewFLock(cfg.InstallerLockFilePath)
+	}
+	return sm
+}
+
+// probeHealth calls sm.healthChecker.Check, bounded by its own timeout so a slow or hung
+// checker only delays this one probe, and logs an unhealthy reason or a check failure via
+// dedupLog. A check failure is treated as unhealthy, the conservative default.
+func (sm *StartupMonitor) probeHealth() bool {
+	ctx, cancel := context.WithTimeout(context.Background(), sm.probeInterval)
+	defer cancel()
+
+	healthy, reason, err := sm.healthChecker.Check(ctx)
+	sm.recordProbeAttempt(err)
+	if err != nil {
+		sm.dedupLog.Warningf("Health check failed for %s, treating it as unhealthy: %v", sm.targetName, err)
+		return false
+	}
+	if !healthy && reason != "" {
+		sm.dedupLog.Infof("Health check reports %s is unhealthy: %s", sm.targetName, reason)
+	}
+	return healthy
+}
+
+// Run blocks until ctx is done, then returns the error recorded by the most recently completed
+// sync iteration, if any, so a caller like (*StartupMonitorOptions).Run can propagate a monitor
+// that was in a failing state at shutdown time as a non-zero exit instead of silently returning.
+func (sm *StartupMonitor) Run(ctx context.Context) error {
+	if singleReplica, err := sm.isSingleReplicaTopology(ctx); err != nil {
+		klog.Warningf("Unable to detect the control plane topology for %s, using the configured timeout: %v", sm.targetName, err)
+	} else if singleReplica {
+		klog.Infof("Detected a SingleReplica control plane topology, using the single-node timeout (%s) for %s instead of %s", sm.singleNodeTimeout, sm.targetName, sm.timeout)
+		sm.timeout = sm.singleNodeTimeout
+	}
+
 	klog.Infof("Starting the startup monitor with Interval = %v, Timeout = %v", sm.probeInterval, sm.timeout)
+	sm.logCapabilities()
 	defer klog.Info("Shutting down the startup monitor")
 
-	wait.Until(sm.syncErrorWrapper, sm.probeInterval, ctx.Done())
+	if err := sm.patchNodeState(ctx, sm.revision, NodeStateMonitoring, ""); err != nil {
+		klog.Warningf("Unable to patch the node state for %s: %v", sm.targetName, err)
+	}
+	if err := sm.writeStateFile(NodeStateMonitoring, "", 0, 0); err != nil {
+		klog.Warningf("Unable to write the state file for %s: %v", sm.targetName, err)
+	}
+
+	// prime the probe cache synchronously before starting the loops below, so the very first
+	// sync iteration already has a fresh health reading instead of racing the health-probe
+	// goroutine's own first tick and, on the loser of that race, wrongly assuming unhealthy for
+	// an entire probeInterval.
+	sm.probeCache.set(sm.probeHealth())
+
+	go sm.runHealthProbeLoop(ctx.Done())
+
+	// seed lastSyncCompletion to now, so a sync that wedges on its very first call (e.g. on a
+	// stuck filesystem during installer-lock contention, or a hung kube client call) is caught
+	// too; without this, syncCompletionAge reports completed=false until the first sync
+	// iteration returns, which the watchdog's guard treats identically to "not yet due".
+	sm.recordSyncCompletion()
+	go sm.runWatchdogLoop(ctx.Done())
+
+	// buffered by 1 so a trigger delivered while a sync is already running isn't lost, but a
+	// burst of several inotify events still only schedules one extra sync.
+	manifestChangedCh := make(chan struct{}, 1)
+	go sm.runManifestWatchLoop(ctx.Done(), manifestChangedCh)
+
+	sm.runSyncLoop(ctx.Done(), manifestChangedCh)
+
+	return sm.GetStatus().LastError
+}
+
+// runSyncLoop calls sm.syncErrorWrapper, then waits for either a jittered probeInterval or a
+// send on manifestChangedCh, whichever comes first, before calling it again, until stopCh is
+// closed. The jitter avoids several operand monitors on the same node, all configured with the
+// same probe interval, performing their disk reads and probes in lockstep every tick;
+// manifestChangedCh, published by runManifestWatchLoop, lets a manifest change short-circuit
+// the rest of the current interval instead of waiting it out.
+func (sm *StartupMonitor) runSyncLoop(stopCh <-chan struct{}, manifestChangedCh <-chan struct{}) {
+	for {
+		select {
+		case <-stopCh:
+			return
+		default:
+		}
+
+		sm.syncErrorWrapper()
+
+		timer := time.NewTimer(wait.Jitter(sm.probeInterval, loopJitterFactor))
+		select {
+		case <-stopCh:
+			timer.Stop()
+			return
+		case <-manifestChangedCh:
+			timer.Stop()
+		case <-timer.C:
+		}
+	}
 }
 
 func (sm *StartupMonitor) syncErrorWrapper() {
-	if err := sm.sync(); err != nil {
+	defer func() {
+		if r := recover(); r != nil {
+			syncPanicsTotal.Inc()
+			klog.Errorf("recovered from a panic in the startup monitor sync loop: %v\n%s", r, debug.Stack())
+		}
+	}()
+	// let the watchdog know the loop is still iterating, whether this tick errors, succeeds
+	// or panics.
+	defer sm.recordSyncCompletion()
+
+	// bound each sync iteration to the probe interval, so a slow network operation (an
+	// apiserver call, a peer or bootstrap apiserver healthz probe) aborts instead of stalling
+	// the sync loop past the fallback deadline or delaying reaction to a manifest change.
+	ctx, cancel := context.WithTimeout(context.Background(), sm.probeInterval)
+	defer cancel()
+
+	start := time.Now()
+	err := sm.sync(ctx)
+	if elapsed := time.Since(start); elapsed > sm.probeInterval {
+		syncOverrunsTotal.Inc()
+		klog.Warningf("Sync iteration for %s took %s, longer than the probe interval %s", sm.targetName, elapsed, sm.probeInterval)
+	}
+
+	sm.recordSyncError(err)
+	if err != nil {
+		syncErrorsTotal.WithLabelValues(string(syncErrorCategoryOf(err))).Inc()
 		klog.Error(err)
 	}
 }
 
-func (sm *StartupMonitor) sync() error {
-	//
-	// TODO: acquire an exclusive lock to coordinate work with the installer pod
+func (sm *StartupMonitor) sync(ctx context.Context) error {
+	// acquire an exclusive lock to coordinate work with the installer pod, if one was
+	// configured via WithInstallerLockFilePath.
 	//
 	// a lock is required to protect the following case:
 	//
@@ -87,45 +719,389 @@ func (sm *StartupMonitor) sync() error {
 	// the installer writes the new file and we immediately overwrite it
 	//
 	// additional benefit is that we read consistent operand's manifest
+	if sm.installerLock != nil {
+		lockWaitStarted := time.Now()
+		if err := sm.installerLock.TryLock(sm.installerLockTimeout); err != nil {
+			return wrapSyncError(SyncErrorCategoryInstallerLock, fmt.Errorf("failed to acquire the installer coordination lock within %s: %w", sm.installerLockTimeout, err))
+		}
+		defer func() {
+			if err := sm.installerLock.Unlock(); err != nil {
+				klog.Warningf("Failed to release the installer coordination lock for %s: %v", sm.targetName, err)
+			}
+		}()
+
+		// an installer actively holding the lock isn't the operand's fault; don't let the
+		// wait for it eat into the fallback budget. installerLockPollInterval is FLock's own
+		// polling interval, so anything at or above it means the lock was actually contended
+		// rather than just the ordinary cost of acquiring an uncontended one.
+		if time.Since(lockWaitStarted) >= installerLockPollInterval {
+			klog.Infof("Waited for the installer coordination lock for %s, pausing the fallback countdown", sm.targetName)
+			sm.pauseCountdown()
+		}
+	}
 
 	// to avoid issues on startup and downgrade (before the startup monitor was introduced check the current target's revision.
 	// refrain from any further processing in case we have a mismatch.
 	currentTargetRevision, err := sm.loadRootTargetPodAndExtractRevision()
 	if err != nil {
-		return err
+		return wrapSyncError(SyncErrorCategoryManifestRead, err)
 	}
 	if sm.revision != currentTargetRevision {
-		klog.Info("Stopping further processing because the monitor is watching revision %d and the current target's revision is %d", sm.revision, currentTargetRevision)
+		if sm.supersededSince.IsZero() {
+			sm.supersededSince = time.Now()
+		}
+		if time.Since(sm.supersededSince) >= sm.supersededGracePeriod {
+			klog.Warningf("Revision %d for %s has been superseded by revision %d for over %s, removing the monitor's own manifest so the kubelet stops it", sm.revision, sm.targetName, currentTargetRevision, sm.supersededGracePeriod)
+			if err := sm.removeOwnManifest(); err != nil {
+				return wrapSyncError(SyncErrorCategoryManifestRead, err)
+			}
+			return nil
+		}
+		sm.dedupLog.Infof("Stopping further processing because the monitor is watching revision %d and the current target's revision is %d", sm.revision, currentTargetRevision)
 		return nil
 	}
+	sm.supersededSince = time.Time{}
 
-	if sm.monitorTimeStamp.IsZero() {
-		sm.monitorTimeStamp = time.Now()
+	now := time.Now()
+	detectClockJump(sm.lastSyncTimeStamp, now)
+	sm.lastSyncTimeStamp = now
+
+	monitorTimeStamp := sm.recordMonitorStart()
+
+	if err := sm.snapshotCurrentManifestIfNeeded(); err != nil {
+		klog.Warningf("Unable to snapshot the current manifest for %s revision %d: %v", sm.targetName, sm.revision, err)
 	}
 
 	// first check if the target is healthy
 	// note that we will always reconcile on transient errors
 	// before starting the fall back procedure
-	if sm.isTargetHealthy() {
+	//
+	// healthChecker itself runs on its own goroutine (see runHealthProbeLoop) and only its
+	// latest published result is read here, so a slow or hung probe can't stall this loop.
+	targetHealthy, probed := sm.probeCache.get()
+	if !probed {
+		targetHealthy = false
+	}
+	if targetHealthy {
+		if mirrorPodReady, err := sm.isMirrorPodReady(ctx); err != nil {
+			sm.dedupLog.Warningf("Unable to confirm mirror pod readiness for %s, ignoring the additional signal: %v", sm.targetName, err)
+		} else if !mirrorPodReady {
+			sm.dedupLog.Infof("Target reported healthy but its mirror pod is not yet Ready, waiting")
+			targetHealthy = false
+		}
+	}
+	if targetHealthy {
+		if certValid, err := sm.isServingCertificateValid(); err != nil {
+			sm.dedupLog.Warningf("Unable to validate the serving certificate for %s, ignoring the additional signal: %v", sm.targetName, err)
+		} else if !certValid {
+			sm.dedupLog.Infof("Target reported healthy but its serving certificate is invalid, waiting")
+			targetHealthy = false
+		}
+	}
+	sm.recordProbeResult(targetHealthy)
+	if targetHealthy {
+		sm.consecutiveSuccesses++
+		sm.consecutiveFailures = 0
+	} else {
+		sm.consecutiveFailures++
+		sm.consecutiveSuccesses = 0
+	}
+
+	// require successThreshold consecutive healthy probes before declaring the target
+	// healthy, so a single flaky 200 doesn't immediately delete the monitor.
+	if targetHealthy && sm.consecutiveSuccesses < sm.successThreshold {
+		sm.dedupLog.Infof("Target reported healthy for %s but only %d/%d consecutive healthy probes observed, waiting", sm.targetName, sm.consecutiveSuccesses, sm.successThreshold)
+		targetHealthy = false
+	}
+	if targetHealthy {
+		if sm.sleepInsteadOfSelfRemoval {
+			if sm.getPhase() != PhaseHealthy {
+				klog.Infof("Observed a healthy target for %s, entering sleep mode: recording the last known good revision and idling instead of removing the monitor's own manifest", sm.targetName)
+			}
+			if err := sm.createLastKnowGoodRevisionFor(sm.revision, true); err != nil {
+				return wrapSyncError(SyncErrorCategoryLastKnownGood, err)
+			}
+			if err := sm.writeHealthyVerdict(sm.revision); err != nil {
+				klog.Warningf("Unable to write the healthy verdict marker for %s revision %d: %v", sm.targetName, sm.revision, err)
+			}
+			if err := sm.archiveKnownGoodSnapshot(sm.revision); err != nil {
+				klog.Warningf("Unable to archive a known-good snapshot for %s revision %d: %v", sm.targetName, sm.revision, err)
+			}
+			if err := sm.recordHealthyEvent(ctx, sm.revision); err != nil {
+				klog.Warningf("Unable to record a healthy event for %s revision %d: %v", sm.targetName, sm.revision, err)
+			}
+			if err := sm.patchNodeState(ctx, sm.revision, NodeStateSucceeded, ""); err != nil {
+				klog.Warningf("Unable to patch the node state for %s: %v", sm.targetName, err)
+			}
+			if err := sm.writeStateFile(NodeStateSucceeded, "", 0, 0); err != nil {
+				klog.Warningf("Unable to write the state file for %s: %v", sm.targetName, err)
+			}
+			sm.setPhase(PhaseHealthy)
+			return nil
+		}
+
 		klog.Info("Observed a healthy target, creating last known good revision")
 		if err := sm.createLastKnowGoodRevisionAndDestroy(); err != nil {
-			return err
+			return wrapSyncError(SyncErrorCategoryLastKnownGood, err)
+		}
+		if err := sm.writeHealthyVerdict(sm.revision); err != nil {
+			klog.Warningf("Unable to write the healthy verdict marker for %s revision %d: %v", sm.targetName, sm.revision, err)
+		}
+		if err := sm.archiveKnownGoodSnapshot(sm.revision); err != nil {
+			klog.Warningf("Unable to archive a known-good snapshot for %s revision %d: %v", sm.targetName, sm.revision, err)
+		}
+		if err := sm.recordHealthyEvent(ctx, sm.revision); err != nil {
+			klog.Warningf("Unable to record a healthy event for %s revision %d: %v", sm.targetName, sm.revision, err)
 		}
+		if err := sm.patchNodeState(ctx, sm.revision, NodeStateSucceeded, ""); err != nil {
+			klog.Warningf("Unable to patch the node state for %s: %v", sm.targetName, err)
+		}
+		if err := sm.writeStateFile(NodeStateSucceeded, "", 0, 0); err != nil {
+			klog.Warningf("Unable to write the state file for %s: %v", sm.targetName, err)
+		}
+		sm.setPhase(PhaseHealthy)
+		return nil
+	}
+
+	// before charging any more time against the fallback budget, make sure the kubelet on
+	// this node is actually alive: an unhealthy operand is meaningless evidence if the
+	// kubelet isn't around to restart it, or to act on the fallback manifest we would write.
+	if alive, err := sm.isKubeletAlive(ctx); err != nil {
+		sm.dedupLog.Warningf("Unable to determine kubelet liveness for %s, charging time against the fallback budget as usual: %v", sm.targetName, err)
+	} else if !alive {
+		sm.dedupLog.Warningf("kubelet does not appear to be alive on this node, pausing the fallback countdown for %s", sm.targetName)
+		sm.pauseCountdown()
+		return nil
+	}
+
+	// operand slowness while the Machine Config Operator is applying an update (including an
+	// rpm-ostree OS update) on this node is expected; don't burn down the fallback budget over it.
+	if updating, err := sm.isMachineConfigUpdateInProgress(ctx); err != nil {
+		sm.dedupLog.Warningf("Unable to determine machine config update state for %s, charging time against the fallback budget as usual: %v", sm.targetName, err)
+	} else if updating {
+		sm.dedupLog.Infof("A machine config update is in progress on this node, pausing the fallback countdown for %s", sm.targetName)
+		sm.pauseCountdown()
 		return nil
 	}
 
-	// check if we reached the timeout
-	if time.Now().After(sm.monitorTimeStamp.Add(sm.timeout)) {
+	// the installer controller may already know, cluster-wide, that this revision was
+	// abandoned or failed (for example another node rejected it first); if so there is no
+	// point waiting out the local timeout.
+	if abandoned, err := sm.revisionAbandonedClusterWide(ctx); err != nil {
+		sm.dedupLog.Warningf("Unable to cross-check the revision status for %s, falling back to the local timeout: %v", sm.targetName, err)
+	} else if abandoned {
+		klog.Infof("Revision %d for %s was already marked failed/abandoned cluster-wide, short-circuiting the local fallback decision", sm.revision, sm.targetName)
+		return sm.performFallback(ctx, ReasonRevisionAbandoned)
+	}
+
+	// check if we reached the timeout. The remaining budget is a duration decremented by the
+	// monotonic time elapsed since monitorTimeStamp, rather than an absolute wall-clock
+	// deadline compared against time.Now(): if an admin corrects the node's clock mid-
+	// countdown, the wall-clock jump doesn't reach this math at all, since sm.clock.Since uses
+	// the monotonic reading embedded in monitorTimeStamp by time.Now for the real clock, and a
+	// directly comparable simulated duration for a fake clock in tests.
+	if remainingBudget := sm.timeout - sm.clock.Since(monitorTimeStamp); remainingBudget <= 0 {
+		// require failureThreshold consecutive unhealthy probes before actually falling
+		// back, so a single transient failure landing right on the deadline doesn't roll
+		// back a target that's otherwise been healthy.
+		if sm.consecutiveFailures < sm.failureThreshold {
+			sm.dedupLog.Infof("Deadline reached for %s but only %d/%d consecutive unhealthy probes observed, waiting for the failure threshold before falling back", sm.targetName, sm.consecutiveFailures, sm.failureThreshold)
+			return nil
+		}
+
+		if progressing, err := sm.isClusterProgressing(ctx); err != nil {
+			klog.Warningf("Unable to determine the cluster-wide rollout state for %s, proceeding with the fall back procedure: %v", sm.targetName, err)
+		} else if progressing && sm.extendDeadline() {
+			klog.Infof("Cluster-wide rollout in progress, extending the local deadline for %s instead of rolling back", sm.targetName)
+			return nil
+		}
+
+		if underPressure, err := sm.isNodeUnderPressure(); err != nil {
+			klog.Warningf("Unable to determine node CPU pressure for %s, proceeding with the fall back procedure: %v", sm.targetName, err)
+		} else if underPressure && sm.extendDeadline() {
+			klog.Infof("Node is under heavy CPU pressure, extending the local deadline for %s instead of rolling back", sm.targetName)
+			return nil
+		}
+
 		klog.Info("Timed out while waiting for the target to become healthy, starting a fall back procedure")
-		if err := sm.fallbackToPreviousRevision(); err != nil {
-			return err
+		return sm.performFallback(ctx, ReasonTimeout)
+	}
+
+	return nil
+}
+
+// performFallback rolls back to the previous revision and reports the outcome through the
+// operator fallback condition, the durable per-node fallback record, a Kubernetes event on the
+// mirror pod or node, and a failed-verdict marker written into the abandoned revision's own
+// resource directory, all best-effort.
+// Before touching anything on disk, it gives every registered FallbackGuardFunc a chance to
+// veto the fallback, and runs a pre-flight free-space and writability check on the manifests
+// filesystem; if either fails, it reports a degraded status and the corresponding operator
+// condition instead of risking a fallback that fails halfway through its remove+write
+// sequence. While observation mode (see WithObservationMode) is still active for the current
+// revision, every check above still runs and the failed-verdict marker is still written, but
+// the actual manifest swap is skipped in favor of reporting FallbackObservedConditionType.
+func (sm *StartupMonitor) performFallback(ctx context.Context, reason FallbackReason) error {
+	if blockedBy, blocked := sm.blockedByFallbackGuard(); blocked {
+		klog.Warningf("Fallback for %s blocked by a fallback guard, reporting a degraded status instead: %s", sm.targetName, blockedBy)
+		sm.setPhase(PhaseDegraded)
+
+		message := fmt.Sprintf("%s wanted to fall back from revision %d but the fallback was blocked: %s", sm.targetName, sm.revision, blockedBy)
+		if err := sm.patchOperatorCondition(ctx, FallbackBlockedConditionType, string(reason), message); err != nil {
+			klog.Warningf("Unable to patch the operator fallback-blocked condition for %s: %v", sm.targetName, err)
+		}
+		return nil
+	}
+
+	if err := sm.preflightManifestsWritable(); err != nil {
+		klog.Warningf("Fallback pre-flight check failed for %s, reporting a degraded status instead: %v", sm.targetName, err)
+		sm.setPhase(PhaseDegraded)
+
+		message := fmt.Sprintf("%s wanted to fall back from revision %d but the manifests filesystem failed a pre-flight check: %v", sm.targetName, sm.revision, err)
+		if err := sm.patchOperatorCondition(ctx, FallbackPreflightFailedConditionType, string(reason), message); err != nil {
+			klog.Warningf("Unable to patch the operator fallback-preflight-failed condition for %s: %v", sm.targetName, err)
+		}
+		return nil
+	}
+
+	// if every other master's apiserver also appears unreachable, rolling back is unlikely
+	// to help and may only trade one known-bad config for another cluster-wide; prefer to
+	// keep the current revision trying (or its rescue manifest, if any) instead.
+	if healthy, err := sm.anyPeerAPIServerHealthy(ctx); err != nil {
+		klog.Warningf("Unable to determine peer apiserver health for %s, proceeding with the fall back procedure: %v", sm.targetName, err)
+	} else if !healthy {
+		klog.Warningf("All peer apiservers appear unreachable for %s, keeping the current revision instead of rolling back into a config that may be known-bad cluster-wide", sm.targetName)
+		sm.pauseCountdown()
+		return nil
+	}
+
+	// gather exit diagnostics for the revision being replaced before it is gone, so the
+	// operator condition and fallback record can distinguish e.g. an OOM kill from a
+	// config-parse crash instead of just reporting a bare timeout.
+	diagnostics, err := sm.containerExitDiagnostics(ctx)
+	if err != nil {
+		klog.Warningf("Unable to fetch container exit diagnostics for %s via the CRI: %v", sm.targetName, err)
+	}
+	diagnosticsSummary := summarizeContainerExitDiagnostics(diagnostics)
+
+	failedReason := string(reason)
+	if len(diagnosticsSummary) > 0 {
+		failedReason += ": " + diagnosticsSummary
+	}
+	if err := sm.writeFailedVerdict(sm.revision, failedReason); err != nil {
+		klog.Warningf("Unable to write the failed verdict marker for %s revision %d: %v", sm.targetName, sm.revision, err)
+	}
+
+	if observing, err := sm.observationModeActive(); err != nil {
+		klog.Warningf("Unable to determine observation mode state for %s, proceeding with the fall back procedure: %v", sm.targetName, err)
+	} else if observing {
+		klog.Infof("Observation mode active for %s, recording that a fallback from revision %d would have happened (%s) instead of performing it", sm.targetName, sm.revision, reason)
+		sm.setPhase(PhaseDegraded)
+
+		message := fmt.Sprintf("%s would have fallen back from revision %d but observation mode is still active", sm.targetName, sm.revision)
+		if len(diagnosticsSummary) > 0 {
+			message += fmt.Sprintf(": %s", diagnosticsSummary)
+		}
+		if err := sm.patchOperatorCondition(ctx, FallbackObservedConditionType, string(reason), message); err != nil {
+			klog.Warningf("Unable to patch the operator fallback-observed condition for %s: %v", sm.targetName, err)
 		}
 		return nil
 	}
 
+	if err := sm.fallbackToPreviousRevision(failedReason); err != nil {
+		return wrapSyncError(SyncErrorCategoryFallback, err)
+	}
+	sm.setPhase(PhaseFallback)
+
+	// sm.revision just triggered a fallback, so it must never be selected as a "previous"
+	// candidate again, e.g. after a later rollout advances past it and then also fails: without
+	// this, findPreviousRevision could walk back onto a revision already known to be broken.
+	if err := sm.recordFallbackAttempt(sm.revision); err != nil {
+		klog.Warningf("Unable to persist %s revision %d as a known-bad fallback candidate: %v", sm.targetName, sm.revision, err)
+	}
+
+	if toRevision, err := sm.revisionOf(sm.lastKnownGoodManifestDstPath()); err != nil {
+		klog.Warningf("Unable to determine the revision fallen back to, skipping the operator fallback condition and fallback record: %v", err)
+	} else {
+		if err := sm.patchOperatorFallbackCondition(ctx, sm.revision, toRevision, reason, diagnosticsSummary); err != nil {
+			klog.Warningf("Unable to patch the operator fallback condition for %s: %v", sm.targetName, err)
+		}
+		if err := sm.recordFallback(ctx, sm.revision, toRevision, diagnosticsSummary); err != nil {
+			klog.Warningf("Unable to record the fallback for %s: %v", sm.targetName, err)
+		}
+		if err := sm.recordFallbackEvent(ctx, sm.revision, toRevision, reason, diagnosticsSummary); err != nil {
+			klog.Warningf("Unable to record a fallback event for %s: %v", sm.targetName, err)
+		}
+		if err := sm.patchNodeState(ctx, toRevision, NodeStateFallbackPerformed, string(reason)); err != nil {
+			klog.Warningf("Unable to patch the node state for %s: %v", sm.targetName, err)
+		}
+		if err := sm.writeStateFile(NodeStateFallbackPerformed, string(reason), sm.revision, toRevision); err != nil {
+			klog.Warningf("Unable to write the state file for %s: %v", sm.targetName, err)
+		}
+	}
+
 	return nil
 }
 
+// recordMonitorStart sets monitorTimeStamp to now the first time it is called and
+// returns its (possibly previously recorded) value. It is safe for concurrent use.
+func (sm *StartupMonitor) recordMonitorStart() time.Time {
+	sm.lock.Lock()
+	defer sm.lock.Unlock()
+	if sm.monitorTimeStamp.IsZero() {
+		sm.monitorTimeStamp = sm.clock.Now()
+	}
+	return sm.monitorTimeStamp
+}
+
+// extendDeadline pushes the deadline out by sm.timeout from now, unless
+// maxRolloutExtensions has already been reached. It reports whether the deadline was
+// extended. It is safe for concurrent use.
+func (sm *StartupMonitor) extendDeadline() bool {
+	sm.lock.Lock()
+	defer sm.lock.Unlock()
+	if sm.rolloutExtensions >= sm.maxRolloutExtensions {
+		return false
+	}
+	sm.monitorTimeStamp = sm.clock.Now()
+	sm.rolloutExtensions++
+	return true
+}
+
+// pauseCountdown pushes the deadline out to sm.timeout from now, without limit, so a dead
+// kubelet doesn't burn down the fallback budget through no fault of the operand. It is safe
+// for concurrent use.
+func (sm *StartupMonitor) pauseCountdown() {
+	sm.lock.Lock()
+	defer sm.lock.Unlock()
+	sm.monitorTimeStamp = sm.clock.Now()
+}
+
+// blockedByFallbackGuard reports whether any registered FallbackGuardFunc vetoes the
+// fallback, along with the first veto's reason.
+func (sm *StartupMonitor) blockedByFallbackGuard() (string, bool) {
+	for _, guard := range sm.fallbackGuards {
+		if allowed, reason := guard(); !allowed {
+			return reason, true
+		}
+	}
+	return "", false
+}
+
+// setPhase records the monitor's current phase. It is safe for concurrent use.
+func (sm *StartupMonitor) setPhase(phase Phase) {
+	sm.lock.Lock()
+	defer sm.lock.Unlock()
+	sm.phase = phase
+}
+
+// getPhase returns the monitor's current phase. It is safe for concurrent use.
+func (sm *StartupMonitor) getPhase() Phase {
+	sm.lock.Lock()
+	defer sm.lock.Unlock()
+	return sm.phase
+}
+
 func (sm *StartupMonitor) createLastKnowGoodRevisionAndDestroy() error {
 	// step 0: rm the previous last good known revision if exists
 	// step 1: create last known good revision
@@ -134,11 +1110,42 @@ func (sm *StartupMonitor) createLastKnowGoodRevisionAndDestroy() error {
 	}
 
 	// step 2: commit suicide
-	return sm.io.Remove(path.Join(sm.manifestsPath, fmt.Sprintf("%s-startup-monitor.yaml", sm.targetName)))
+	return sm.removeOwnManifest()
+}
+
+// removeOwnManifest removes the startup monitor's own static pod manifest, so the kubelet
+// stops this monitor's pod. It is idempotent: a manifest already gone is not an error.
+func (sm *StartupMonitor) removeOwnManifest() error {
+	err := retryOnTransientError(func() error {
+		return sm.io.Remove(path.Join(sm.manifestsPath, fmt.Sprintf("%s-startup-monitor.yaml", sm.targetName)))
+	})
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
 }
 
-// TODO: pruner|installer: protect the linked revision
-func (sm *StartupMonitor) fallbackToPreviousRevision() error {
+// fallbackToPreviousRevision rolls back to the previous revision, annotating the fallback pod's
+// manifest with failureReason (see fallbackReasonAnnotationSuffix) alongside the other
+// context annotations, so an operator can reconstruct what happened from the pod manifest
+// alone. failureReason may be empty, in which case the annotation is omitted.
+func (sm *StartupMonitor) fallbackToPreviousRevision(failureReason string) error {
+	if sm.pinnedFallbackRevision != 0 {
+		pinnedManifestPath := sm.targetManifestPathFor(sm.pinnedFallbackRevision)
+		exists, err := sm.fileExists(pinnedManifestPath)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("pinned fallback revision %d has no manifest at %q", sm.pinnedFallbackRevision, pinnedManifestPath)
+		}
+		klog.InfoS("Falling back to a pinned revision instead of running the last-known-good/N-1 heuristic", "target", sm.targetName, "revision", sm.pinnedFallbackRevision)
+		if err := sm.createLastKnowGoodRevisionFor(sm.pinnedFallbackRevision, true); err != nil {
+			return err
+		}
+		return sm.writeFallbackManifest(failureReason)
+	}
+
 	// step 0: if the last known good revision doesn't exist
 	//         find a previous revision to work with
 	//         return in case no revision has been found
@@ -147,25 +1154,49 @@ func (sm *StartupMonitor) fallbackToPreviousRevision() error {
 	if err != nil {
 		return err
 	}
-	if !lastKnownExists {
-		prevRev, found, err := sm.findPreviousRevision()
+	if !lastKnownExists && sm.lastKnownGoodHistorySize > 1 {
+		promoted, err := sm.promoteHistoricalLastKnownGood()
 		if err != nil {
 			return err
 		}
-		if !found {
-			klog.Info("Unable to roll back because no previous revision hasn't been found for %s", sm.targetName)
-			// TODO: commit suicide ? this seems to be fatal
-			return nil
+		if promoted {
+			lastKnownExists = true
 		}
-
-		targetManifestForPrevRevExists, err := sm.fileExists(sm.targetManifestPathFor(prevRev))
-		if err != nil {
-			return err // retry, a transient err
+	}
+	if !lastKnownExists {
+		if err := sm.loadFallbackAttemptsIntoBlacklist(); err != nil {
+			return err
 		}
-		if !targetManifestForPrevRevExists {
-			klog.Info("Unable to roll back because a manifest %q hasn't been found for the previous revision %d", sm.targetManifestPathFor(prevRev), prevRev)
-			// TODO: commit suicide ? this seems to be fatal
-			return nil
+
+		// walk candidates from most to least recent (N-1, N-2, ...), skipping and
+		// recording any whose manifest is missing, until one usable candidate is found
+		// or the resource directory is exhausted.
+		var prevRev int
+		for {
+			var found bool
+			prevRev, found, err = sm.findPreviousRevision()
+			if err != nil {
+				return err
+			}
+			if !found {
+				klog.InfoS("Unable to roll back: no previous revision has been found", "target", sm.targetName)
+				// TODO: commit suicide ? this seems to be fatal
+				return nil
+			}
+
+			targetManifestForPrevRevExists, err := sm.fileExists(sm.targetManifestPathFor(prevRev))
+			if err != nil {
+				return err // retry, a transient err
+			}
+			if !targetManifestForPrevRevExists {
+				klog.Infof("Manifest %q for %s revision %d is missing, recording it as a tried fallback candidate and looking further back", sm.targetManifestPathFor(prevRev), sm.targetName, prevRev)
+				if err := sm.recordFallbackAttempt(prevRev); err != nil {
+					return err
+				}
+				continue
+			}
+
+			break
 		}
 
 		// step 1: create the last known good revision file
@@ -174,32 +1205,81 @@ func (sm *StartupMonitor) fallbackToPreviousRevision() error {
 		}
 	}
 
-	// step 2: if the last known good revision exits and we got here
-	//         that could mean that:
-	//          - the current revision is broken
-	//          - we just created the last known good revision file
-	//          - the previous iteration of the sync loop returned an error
-	//
-	//         in that case just:
-	//          - annotate the manifest
-	//          - copy the last known good revision manifest
+	return sm.writeFallbackManifest(failureReason)
+}
+
+// writeFallbackManifest performs the actual rollback write once a last known good revision has
+// been established, either by the pinned-revision override or by fallbackToPreviousRevision's
+// last-known-good/N-1 heuristic. That could mean that:
+//   - the current revision is broken
+//   - we just created the last known good revision file
+//   - the previous iteration of the sync loop returned an error
+//
+// in that case just:
+//   - annotate the manifest
+//   - copy the last known good revision manifest
+func (sm *StartupMonitor) writeFallbackManifest(failureReason string) error {
 	lastKnownGoodPod, err := sm.readTargetPod(sm.lastKnownGoodManifestDstPath())
 	if err != nil {
 		return err
 	}
+
+	if sm.layout.RequiresSelfManagedSnapshots() || sm.verifyDependentFiles {
+		toRevision, err := revisionOfPod(lastKnownGoodPod)
+		if err != nil {
+			klog.Warningf("Unable to determine the revision being restored for %s, skipping host path volume restoration and dependent file verification: %v", sm.targetName, err)
+		} else {
+			if sm.layout.RequiresSelfManagedSnapshots() {
+				sm.restoreHostPathVolumes(lastKnownGoodPod, toRevision)
+			}
+			if sm.verifyDependentFiles {
+				sm.verifyAndRepairDependentFiles(toRevision)
+			}
+		}
+	}
+
 	if lastKnownGoodPod.Annotations == nil {
 		lastKnownGoodPod.Annotations = map[string]string{}
 	}
-	lastKnownGoodPod.Annotations["startup-monitor.static-pods.openshift.io/fallback-for-revision"] = fmt.Sprintf("%d", sm.revision)
+	sm.lock.Lock()
+	probeCount := sm.probeCount
+	sm.lock.Unlock()
+
+	lastKnownGoodPod.Annotations[sm.annotationKey(fallbackForRevisionAnnotationSuffix)] = fmt.Sprintf("%d", sm.revision)
+	lastKnownGoodPod.Annotations[sm.annotationKey(fallbackTimestampAnnotationSuffix)] = sm.clock.Now().UTC().Format(time.RFC3339)
+	lastKnownGoodPod.Annotations[sm.annotationKey(fallbackProbeCountAnnotationSuffix)] = fmt.Sprintf("%d", probeCount)
+	lastKnownGoodPod.Annotations[sm.annotationKey(monitorVersionAnnotationSuffix)] = Version
+	if failureReason != "" {
+		lastKnownGoodPod.Annotations[sm.annotationKey(fallbackReasonAnnotationSuffix)] = failureReason
+	}
+
+	for _, fn := range sm.fallbackMetadataFuncs {
+		extraAnnotations, extraLabels := fn(sm.revision)
+		for k, v := range extraAnnotations {
+			lastKnownGoodPod.Annotations[k] = v
+		}
+		if len(extraLabels) > 0 && lastKnownGoodPod.Labels == nil {
+			lastKnownGoodPod.Labels = map[string]string{}
+		}
+		for k, v := range extraLabels {
+			lastKnownGoodPod.Labels[k] = v
+		}
+	}
 
 	// the kubelet has a bug that prevents graceful termination from working on static pods with the same name, filename
 	// and uuid.  By setting the pod UID we can work around the kubelet bug and get our graceful termination honored.
 	// Per the node team, this is hard to fix in the kubelet, though it will affect all static pods.
-	lastKnownGoodPod.UID = uuid.NewUUID()
+	lastKnownGoodPod.UID = sm.uidProvider()
+
+	// remove the existing file to ensure kubelet gets "create" event from inotify watchers. The
+	// node must never observe neither the old nor the new manifest present, so a second
+	// shutdown signal arriving between the remove below and the write that follows waits for
+	// this span to finish instead of exiting immediately; see enterCriticalSection.
+	leaveCriticalSection := enterCriticalSection()
+	defer leaveCriticalSection()
 
-	// remove the existing file to ensure kubelet gets "create" event from inotify watchers
-	rootTargetManifestPath := path.Join(sm.manifestsPath, fmt.Sprintf("%s-pod.yaml", sm.targetName))
-	if err := sm.io.Remove(rootTargetManifestPath); err == nil {
+	rootTargetManifestPath := sm.rootManifestPath()
+	if err := retryOnTransientError(func() error { return sm.io.Remove(rootTargetManifestPath) }); err == nil {
 		klog.Infof("Removed existing static pod manifest %q", path.Join(rootTargetManifestPath))
 	} else if !os.IsNotExist(err) {
 		return err
@@ -207,7 +1287,7 @@ func (sm *StartupMonitor) fallbackToPreviousRevision() error {
 
 	lastKnownGoodPodBytes := []byte(resourceread.WritePodV1OrDie(lastKnownGoodPod))
 	klog.Infof("Writing a static pod manifest %q \n%s", path.Join(rootTargetManifestPath), lastKnownGoodPodBytes)
-	if err := sm.io.WriteFile(path.Join(rootTargetManifestPath), lastKnownGoodPodBytes, 0644); err != nil {
+	if err := retryOnTransientError(func() error { return sm.io.WriteFileAtomic(path.Join(rootTargetManifestPath), lastKnownGoodPodBytes, 0644) }); err != nil {
 		return err
 	}
 
@@ -215,26 +1295,112 @@ func (sm *StartupMonitor) fallbackToPreviousRevision() error {
 	return nil
 }
 
+// rotateLastKnownGoodHistory shifts each existing last known good pointer one slot older,
+// dropping the oldest once the configured history size is exceeded, freeing up the current
+// slot for createLastKnowGoodRevisionFor to then (re)create fresh. It is a best-effort
+// maintenance step: a failure to preserve one historical pointer is logged but must not block
+// recording the new one.
+func (sm *StartupMonitor) rotateLastKnownGoodHistory() {
+	for i := sm.lastKnownGoodHistorySize - 1; i >= 1; i-- {
+		olderPath := sm.lastKnownGoodManifestDstPathAt(i - 1)
+		newerPath := sm.lastKnownGoodManifestDstPathAt(i)
+
+		revision, err := sm.revisionOf(olderPath)
+		if err != nil {
+			// nothing at this slot yet to shift down; make sure a stale pointer isn't left
+			// behind at the slot it would have shifted into.
+			if exists, statErr := sm.fileExists(newerPath); statErr == nil && exists {
+				if err := sm.io.Remove(newerPath); err != nil {
+					klog.Warningf("Unable to remove the stale last known good history pointer %q for %s: %v", newerPath, sm.targetName, err)
+				}
+			}
+			continue
+		}
+
+		if exists, statErr := sm.fileExists(newerPath); statErr == nil && exists {
+			if err := sm.io.Remove(newerPath); err != nil {
+				klog.Warningf("Unable to remove the previous last known good history pointer %q for %s: %v", newerPath, sm.targetName, err)
+				continue
+			}
+		}
+		if err := sm.symlinkOrCopy(sm.targetManifestPathFor(revision), newerPath); err != nil {
+			klog.Warningf("Unable to preserve last known good history pointer %q (revision %d) for %s: %v", newerPath, revision, sm.targetName, err)
+		}
+	}
+}
+
+// promoteHistoricalLastKnownGood looks for the newest historical last known good pointer that
+// is still present and not blacklisted, and, if found, (re)creates the current pointer from
+// it, giving fallbackToPreviousRevision an already-vetted target to try before falling back to
+// scanning the resource directory for any older revision. It reports whether a pointer was
+// promoted.
+func (sm *StartupMonitor) promoteHistoricalLastKnownGood() (bool, error) {
+	for i := 1; i < sm.lastKnownGoodHistorySize; i++ {
+		historicalPath := sm.lastKnownGoodManifestDstPathAt(i)
+		exists, err := sm.fileExists(historicalPath)
+		if err != nil {
+			return false, err
+		}
+		if !exists {
+			continue
+		}
+
+		revision, err := sm.revisionOf(historicalPath)
+		if err != nil {
+			klog.Warningf("Unable to read the last known good history pointer %q for %s, skipping it: %v", historicalPath, sm.targetName, err)
+			continue
+		}
+		if sm.blacklistedRevisions[revision] {
+			continue
+		}
+
+		if err := sm.createLastKnowGoodRevisionFor(revision, true); err != nil {
+			return false, err
+		}
+		klog.Infof("Promoted last known good history pointer %q (revision %d) for %s to the current one", historicalPath, revision, sm.targetName)
+		return true, nil
+	}
+	return false, nil
+}
+
 func (sm *StartupMonitor) createLastKnowGoodRevisionFor(revision int, strict bool) error {
 	var revisionedTargetManifestPath = sm.targetManifestPathFor(revision)
 
-	// step 0: in strict mode remove the previous last good known revision if exists
+	// step 0: in strict mode remove the previous last good known revision if exists, first
+	// preserving it in the history if it's about to be replaced by a different revision.
+	previousRevision, havePreviousRevision := -1, false
 	if strict {
+		if sm.lastKnownGoodHistorySize > 1 {
+			if currentRevision, err := sm.revisionOf(sm.lastKnownGoodManifestDstPath()); err == nil && currentRevision != revision {
+				sm.rotateLastKnownGoodHistory()
+			}
+		}
 		if exists, err := sm.fileExists(sm.lastKnownGoodManifestDstPath()); err != nil {
 			return err
 		} else if exists {
-			if err := sm.io.Remove(sm.lastKnownGoodManifestDstPath()); err != nil {
+			if currentRevision, err := sm.revisionOf(sm.lastKnownGoodManifestDstPath()); err == nil {
+				previousRevision, havePreviousRevision = currentRevision, true
+			}
+			if err := retryOnTransientError(func() error { return sm.io.Remove(sm.lastKnownGoodManifestDstPath()) }); err != nil {
 				return err
 			}
-			klog.Info("Removed existing last known good revision manifest %s", sm.lastKnownGoodManifestDstPath())
+			klog.InfoS("Removed existing last known good revision manifest", "path", sm.lastKnownGoodManifestDstPath())
 		}
 	}
 
 	// step 1: create last known good revision
-	if err := sm.io.Symlink(revisionedTargetManifestPath, sm.lastKnownGoodManifestDstPath()); err != nil {
+	if err := sm.symlinkOrCopy(revisionedTargetManifestPath, sm.lastKnownGoodManifestDstPath()); err != nil {
 		return fmt.Errorf("failed to create a symbolic link %q for %q due to %v", sm.lastKnownGoodManifestDstPath(), revisionedTargetManifestPath, err)
 	}
-	klog.Info("Created a symlink %s for %s", sm.lastKnownGoodManifestDstPath(), revisionedTargetManifestPath)
+
+	// step 2: protect the linked revision from a concurrent pruner, and release the
+	// protection held by whatever revision it replaced.
+	if err := sm.protectRevision(revision); err != nil {
+		return err
+	}
+	if havePreviousRevision && previousRevision != revision {
+		sm.unprotectRevision(previousRevision)
+	}
 	return nil
 }
 
@@ -244,21 +1410,58 @@ func (sm *StartupMonitor) createLastKnowGoodRevisionFor(revision int, strict boo
 // note if this code will return buffered data due to perf reason revisit fallbackToPreviousRevision
 // as it currently assumes strong consistency
 func (sm *StartupMonitor) loadRootTargetPodAndExtractRevision() (int, error) {
-	currentTargetPod, err := sm.readTargetPod(path.Join(sm.manifestsPath, fmt.Sprintf("%s-pod.yaml", sm.targetName)))
+	targetPod, err := sm.readRootTargetPodCached()
+	if err != nil {
+		return 0, err
+	}
+	return revisionOfPod(targetPod)
+}
+
+// readRootTargetPodCached reads and parses the root manifest, reusing the previous sync's
+// parsed pod when the file's (mtime, size, inode) identity hasn't changed. This is the only
+// caller of readTargetPod hot enough (once per probeInterval) to be worth caching.
+func (sm *StartupMonitor) readRootTargetPodCached() (*corev1.Pod, error) {
+	filepath := sm.rootManifestPath()
+
+	info, err := sm.io.Stat(filepath)
+	if err != nil {
+		return nil, err
+	}
+	identity := fileIdentityOf(info)
+	if sm.rootManifestCache.pod != nil && sm.rootManifestCache.identity == identity {
+		return sm.rootManifestCache.pod, nil
+	}
+
+	targetPod, err := sm.readTargetPod(filepath)
+	if err != nil {
+		return nil, err
+	}
+	sm.rootManifestCache.identity = identity
+	sm.rootManifestCache.pod = targetPod
+	return targetPod, nil
+}
+
+// revisionOf reads the pod manifest at filepath and extracts its revision label.
+func (sm *StartupMonitor) revisionOf(filepath string) (int, error) {
+	targetPod, err := sm.readTargetPod(filepath)
 	if err != nil {
 		return 0, err
 	}
+	return revisionOfPod(targetPod)
+}
 
-	revisionString, found := currentTargetPod.Labels["revision"]
+// revisionOfPod extracts and validates targetPod's revision label.
+func revisionOfPod(targetPod *corev1.Pod) (int, error) {
+	revisionString, found := targetPod.Labels["revision"]
 	if !found {
-		return 0, fmt.Errorf("pod %s doesn't have revision label", currentTargetPod.Name)
+		return 0, fmt.Errorf("pod %s doesn't have revision label", targetPod.Name)
 	}
 	if len(revisionString) == 0 {
-		return 0, fmt.Errorf("empty revision label on %s pod", currentTargetPod.Name)
+		return 0, fmt.Errorf("empty revision label on %s pod", targetPod.Name)
 	}
 	revision, err := strconv.Atoi(revisionString)
 	if err != nil || revision < 0 {
-		return 0, fmt.Errorf("invalid revision label on pod %s: %q", currentTargetPod.Name, revisionString)
+		return 0, fmt.Errorf("invalid revision label on pod %s: %q", targetPod.Name, revisionString)
 	}
 
 	return revision, nil
@@ -276,7 +1479,88 @@ func (sm *StartupMonitor) readTargetPod(filepath string) (*corev1.Pod, error) {
 	return currentTargetPod, nil
 }
 
+// fallbackAttemptsHistoryPath returns the path of the ledger recording every revision known to
+// be a bad fallback candidate, either because fallbackToPreviousRevision already walked past it
+// while its manifest was missing, or because it was itself the revision a fallback was
+// triggered from, so a restarted monitor doesn't rescan and reconsider a candidate a previous
+// instance already rejected.
+func (sm *StartupMonitor) fallbackAttemptsHistoryPath() string {
+	return path.Join(sm.staticPodResourcesPath, fmt.Sprintf("%s-fallback-attempts", sm.targetName))
+}
+
+// loadFallbackAttemptsIntoBlacklist merges the on-disk fallback-attempts ledger into
+// sm.blacklistedRevisions, so findPreviousRevision also skips candidates a prior monitor
+// instance already tried and rejected, not just the ones passed via WithBlacklistedRevisions.
+func (sm *StartupMonitor) loadFallbackAttemptsIntoBlacklist() error {
+	tried, err := sm.readRevisionLedger(sm.fallbackAttemptsHistoryPath())
+	if err != nil {
+		return err
+	}
+	if len(tried) == 0 {
+		return nil
+	}
+	if sm.blacklistedRevisions == nil {
+		sm.blacklistedRevisions = make(map[int]bool, len(tried))
+	}
+	for _, revision := range tried {
+		sm.blacklistedRevisions[revision] = true
+	}
+	return nil
+}
+
+// recordFallbackAttempt blacklists revision as a fallback candidate for the remainder of this
+// process's lifetime and appends it to the fallback-attempts ledger so the rejection survives a
+// restart, then invalidates previousRevisionCache so the very next findPreviousRevision call
+// re-scans instead of returning the now-stale, already-rejected candidate. Called both while
+// walking past a candidate whose manifest is missing, and once performFallback has fallen back
+// away from a revision, so that revision isn't selected again as "previous" later.
+func (sm *StartupMonitor) recordFallbackAttempt(revision int) error {
+	if sm.blacklistedRevisions == nil {
+		sm.blacklistedRevisions = make(map[int]bool, 1)
+	}
+	sm.blacklistedRevisions[revision] = true
+	sm.previousRevisionCache.populated = false
+
+	tried, err := sm.readRevisionLedger(sm.fallbackAttemptsHistoryPath())
+	if err != nil {
+		return err
+	}
+	for _, existing := range tried {
+		if existing == revision {
+			return nil
+		}
+	}
+	return sm.writeRevisionLedger(sm.fallbackAttemptsHistoryPath(), append(tried, revision))
+}
+
+// findPreviousRevision scans sm.staticPodResourcesPath for revisioned resource directories and
+// returns the second-most-recent revision, i.e. the one to fall back to. The scan is cached and
+// keyed off the directory's own (mtime, size, inode) identity, so repeated calls during a
+// fallback's retries don't rescan a directory containing dozens of revisions and resource files
+// unless it actually changed.
 func (sm *StartupMonitor) findPreviousRevision() (int, bool, error) {
+	dirInfo, err := sm.io.Stat(sm.staticPodResourcesPath)
+	if err != nil {
+		return 0, false, err
+	}
+	identity := fileIdentityOf(dirInfo)
+	if sm.previousRevisionCache.populated && sm.previousRevisionCache.identity == identity {
+		return sm.previousRevisionCache.revision, sm.previousRevisionCache.found, nil
+	}
+
+	revision, found, err := sm.scanPreviousRevision()
+	if err != nil {
+		return 0, false, err
+	}
+	sm.previousRevisionCache.populated = true
+	sm.previousRevisionCache.identity = identity
+	sm.previousRevisionCache.revision = revision
+	sm.previousRevisionCache.found = found
+	return revision, found, nil
+}
+
+// scanPreviousRevision does the actual directory scan behind findPreviousRevision's cache.
+func (sm *StartupMonitor) scanPreviousRevision() (int, bool, error) {
 	files, err := sm.io.ReadDir(sm.staticPodResourcesPath)
 	if err != nil {
 		return 0, false, err
@@ -311,7 +1595,20 @@ func (sm *StartupMonitor) findPreviousRevision() (int, bool, error) {
 		return 0, false, nil
 	}
 	sort.IntSlice(allRevisions).Sort()
-	return allRevisions[len(allRevisions)-2], true, nil
+
+	// allRevisions[len-1] is the most recent revision, assumed to be the one currently being
+	// guarded; it is never itself a fallback candidate. Walk the rest from most to least
+	// recent, skipping any revision on the blacklist, e.g. one already tried and abandoned by
+	// a previous fallback.
+	for i := len(allRevisions) - 2; i >= 0; i-- {
+		revision := allRevisions[i]
+		if sm.blacklistedRevisions[revision] {
+			klog.Infof("Skipping blacklisted revision %d for %s", revision, sm.targetName)
+			continue
+		}
+		return revision, true, nil
+	}
+	return 0, false, nil
 }
 
 func (sm *StartupMonitor) fileExists(filepath string) (bool, error) {
@@ -328,10 +1625,25 @@ func (sm *StartupMonitor) fileExists(filepath string) (bool, error) {
 	return false, nil
 }
 
+func (sm *StartupMonitor) rootManifestPath() string {
+	return sm.layout.RootManifestPath(sm.manifestsPath, sm.targetName)
+}
+
 func (sm *StartupMonitor) lastKnownGoodManifestDstPath() string {
-	return path.Join(sm.staticPodResourcesPath, fmt.Sprintf("%s-last-known-good", sm.targetName))
+	return sm.layout.LastKnownGoodManifestDstPath(sm.staticPodResourcesPath, sm.targetName)
+}
+
+// lastKnownGoodManifestDstPathAt returns the path of the index'th most recent last known good
+// pointer: index 0 is the current one, i.e. lastKnownGoodManifestDstPath, index 1 is the next
+// most recent, and so on.
+func (sm *StartupMonitor) lastKnownGoodManifestDstPathAt(index int) string {
+	current := sm.lastKnownGoodManifestDstPath()
+	if index == 0 {
+		return current
+	}
+	return fmt.Sprintf("%s.%d", current, index)
 }
 
 func (sm *StartupMonitor) targetManifestPathFor(revision int) string {
-	return path.Join(sm.staticPodResourcesPath, fmt.Sprintf("%s-pod-%d", sm.targetName, revision), fmt.Sprintf("%s-pod.yaml", sm.targetName))
+	return sm.layout.TargetManifestPathFor(sm.staticPodResourcesPath, sm.targetName, revision)
 }
@@ -8,8 +8,9 @@ import (
 	"time"
 
 	"github.com/openshift/library-go/pkg/operator/resource/resourceread"
+	"github.com/p0lyn0mial/startup-monitor/monitor/monitortesting"
 	"k8s.io/apimachinery/pkg/api/equality"
-	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/clock"
 )
 
 var samplePod = `
@@ -22,19 +23,19 @@ metadata:
 func TestFallbackToPreviousRevision(t *testing.T) {
 	scenarios := []struct {
 		name        string
-		fakeIO      *fakeIO
+		fakeIO      *monitortesting.FakeIO
 		expectedErr string
 	}{
 		// scenario 1
 		{
 			name: "happy path",
-			fakeIO: &fakeIO{
-				ExpectedStatFnCounter: 1, ExpectedReadFileFnCounter: 1, ExpectedWriteFileFnCounter: 1, ExpectedRemoveFnCounter: 1,
+			fakeIO: &monitortesting.FakeIO{
+				ExpectedStatFnCounter: 1, ExpectedReadFileFnCounter: 1, ExpectedWriteFileAtomicFnCounter: 1, ExpectedRemoveFnCounter: 1,
 				StatFn: func(path string) (os.FileInfo, error) {
 					if path != "/etc/kubernetes/static-pod-resources/kube-apiserver-last-known-good" {
 						return nil, fmt.Errorf("unexpected path %s", path)
 					}
-					return fakeFile("/etc/kubernetes/static-pod-resources/kube-apiserver-last-known-good"), nil
+					return monitortesting.FakeFile("/etc/kubernetes/static-pod-resources/kube-apiserver-last-known-good"), nil
 				},
 				ReadFileFn: func(path string) ([]byte, error) {
 					if path != "/etc/kubernetes/static-pod-resources/kube-apiserver-last-known-good" {
@@ -42,7 +43,7 @@ func TestFallbackToPreviousRevision(t *testing.T) {
 					}
 					return []byte(samplePod), nil
 				},
-				WriteFileFn: func(filename string, data []byte, perm fs.FileMode) error {
+				WriteFileAtomicFn: func(filename string, data []byte, perm fs.FileMode) error {
 					if filename != "/etc/kubernetes/manifests/kube-apiserver-pod.yaml" {
 						return fmt.Errorf("unexpected path %s", filename)
 					}
@@ -57,6 +58,9 @@ func TestFallbackToPreviousRevision(t *testing.T) {
 					expectedPod.UID = actualPod.UID
 					expectedPod.Annotations = map[string]string{}
 					expectedPod.Annotations["startup-monitor.static-pods.openshift.io/fallback-for-revision"] = "8"
+					expectedPod.Annotations["startup-monitor.static-pods.openshift.io/fallback-timestamp"] = "1970-01-01T00:00:00Z"
+					expectedPod.Annotations["startup-monitor.static-pods.openshift.io/fallback-probe-count"] = "0"
+					expectedPod.Annotations["startup-monitor.static-pods.openshift.io/monitor-version"] = "unknown"
 					if !equality.Semantic.DeepEqual(actualPod, expectedPod) {
 						return fmt.Errorf("unexpected pod was written")
 					}
@@ -68,16 +72,22 @@ func TestFallbackToPreviousRevision(t *testing.T) {
 		// scenario 2
 		{
 			name: "last known doesn't exist",
-			fakeIO: &fakeIO{
-				ExpectedStatFnCounter: 2, ExpectedReadDirFnCounter: 1, ExpectedWriteFileFnCounter: 1, ExpectedRemoveFnCounter: 1, ExpectedReadFileFnCounter: 1, ExpectedSymlinkFnCounter: 1,
+			fakeIO: &monitortesting.FakeIO{
+				ExpectedStatFnCounter: 4, ExpectedReadDirFnCounter: 1, ExpectedWriteFileAtomicFnCounter: 1, ExpectedWriteFileFnCounter: 1, ExpectedRemoveFnCounter: 1, ExpectedReadFileFnCounter: 1, ExpectedSymlinkFnCounter: 1,
 				StatFn: func(path string) (os.FileInfo, error) {
 					switch path {
+					// findPreviousRevision's directory identity check
+					case "/etc/kubernetes/static-pod-resources":
+						return monitortesting.FakeDir("static-pod-resources"), nil
 					// first call
 					case "/etc/kubernetes/static-pod-resources/kube-apiserver-last-known-good":
 						return nil, os.ErrNotExist
+					// loadFallbackAttemptsIntoBlacklist: no prior attempts recorded yet
+					case "/etc/kubernetes/static-pod-resources/kube-apiserver-fallback-attempts":
+						return nil, os.ErrNotExist
 					// second call
 					case "/etc/kubernetes/static-pod-resources/kube-apiserver-pod-9/kube-apiserver-pod.yaml":
-						return fakeFile("/etc/kubernetes/static-pod-resources/kube-apiserver-pod-9/kube-apiserver-pod.yaml"), nil
+						return monitortesting.FakeFile("/etc/kubernetes/static-pod-resources/kube-apiserver-pod-9/kube-apiserver-pod.yaml"), nil
 					default:
 						return nil, fmt.Errorf("unexpected path %s", path)
 					}
@@ -86,7 +96,7 @@ func TestFallbackToPreviousRevision(t *testing.T) {
 					if path != "/etc/kubernetes/static-pod-resources" {
 						return nil, fmt.Errorf("unexpected path %s", path)
 					}
-					return []os.FileInfo{fakeDir("kube-apiserver-pod-7"), fakeDir("kube-apiserver-pod-12"), fakeDir("kube-apiserver-pod-9")}, nil
+					return []os.FileInfo{monitortesting.FakeDir("kube-apiserver-pod-7"), monitortesting.FakeDir("kube-apiserver-pod-12"), monitortesting.FakeDir("kube-apiserver-pod-9")}, nil
 				},
 				SymlinkFn: func(oldname, newname string) error {
 					if oldname != "/etc/kubernetes/static-pod-resources/kube-apiserver-pod-9/kube-apiserver-pod.yaml" {
@@ -104,23 +114,9 @@ func TestFallbackToPreviousRevision(t *testing.T) {
 					return []byte(samplePod), nil
 				},
 				WriteFileFn: func(filename string, data []byte, perm fs.FileMode) error {
-					if filename != "/etc/kubernetes/manifests/kube-apiserver-pod.yaml" {
+					if filename != "/etc/kubernetes/static-pod-resources/kube-apiserver-pod-9.protected" {
 						return fmt.Errorf("unexpected path %s", filename)
 					}
-					actualPod, err := resourceread.ReadPodV1(data)
-					if err != nil {
-						return err
-					}
-					expectedPod, err := resourceread.ReadPodV1([]byte(samplePod))
-					if err != nil {
-						return err
-					}
-					expectedPod.UID = actualPod.UID
-					expectedPod.Annotations = map[string]string{}
-					expectedPod.Annotations["startup-monitor.static-pods.openshift.io/fallback-for-revision"] = "8"
-					if !equality.Semantic.DeepEqual(actualPod, expectedPod) {
-						return fmt.Errorf("unexpected pod was written")
-					}
 					return nil
 				},
 			},
@@ -133,8 +129,8 @@ func TestFallbackToPreviousRevision(t *testing.T) {
 			target := createTestTarget(scenario.fakeIO)
 
 			// act
-			err := target.fallbackToPreviousRevision()
-			validateError(t, err, scenario.expectedErr)
+			err := target.fallbackToPreviousRevision("")
+			monitortesting.AssertError(t, err, scenario.expectedErr)
 			if err := scenario.fakeIO.Validate(); err != nil {
 				t.Error(err)
 			}
@@ -145,7 +141,7 @@ func TestFallbackToPreviousRevision(t *testing.T) {
 func TestFindPreviousRevision(t *testing.T) {
 	scenarios := []struct {
 		name   string
-		fakeIO *fakeIO
+		fakeIO *monitortesting.FakeIO
 
 		expectedPrevRev int
 		expectedErr     string
@@ -154,7 +150,11 @@ func TestFindPreviousRevision(t *testing.T) {
 		// scenario 1
 		{
 			name: "ReadDir error",
-			fakeIO: &fakeIO{
+			fakeIO: &monitortesting.FakeIO{
+				ExpectedStatFnCounter: 1,
+				StatFn: func(path string) (os.FileInfo, error) {
+					return monitortesting.FakeDir("static-pod-resources"), nil
+				},
 				ExpectedReadDirFnCounter: 1,
 				ReadDirFn: func(path string) ([]os.FileInfo, error) {
 					if path != "/etc/kubernetes/static-pod-resources" {
@@ -169,7 +169,11 @@ func TestFindPreviousRevision(t *testing.T) {
 		// scenario 2
 		{
 			name: "ReadDir returns empty result",
-			fakeIO: &fakeIO{
+			fakeIO: &monitortesting.FakeIO{
+				ExpectedStatFnCounter: 1,
+				StatFn: func(path string) (os.FileInfo, error) {
+					return monitortesting.FakeDir("static-pod-resources"), nil
+				},
 				ExpectedReadDirFnCounter: 1,
 				ReadDirFn: func(path string) ([]os.FileInfo, error) {
 					if path != "/etc/kubernetes/static-pod-resources" {
@@ -183,13 +187,17 @@ func TestFindPreviousRevision(t *testing.T) {
 		// scenario 3
 		{
 			name: "ReadDir returns files only",
-			fakeIO: &fakeIO{
+			fakeIO: &monitortesting.FakeIO{
+				ExpectedStatFnCounter: 1,
+				StatFn: func(path string) (os.FileInfo, error) {
+					return monitortesting.FakeDir("static-pod-resources"), nil
+				},
 				ExpectedReadDirFnCounter: 1,
 				ReadDirFn: func(path string) ([]os.FileInfo, error) {
 					if path != "/etc/kubernetes/static-pod-resources" {
 						return nil, fmt.Errorf("unexpected path %s", path)
 					}
-					return []os.FileInfo{fakeFile("kube-apiserver-pod-11"), fakeFile("kube-apiserver-pod-12")}, nil
+					return []os.FileInfo{monitortesting.FakeFile("kube-apiserver-pod-11"), monitortesting.FakeFile("kube-apiserver-pod-12")}, nil
 				},
 			},
 		},
@@ -197,13 +205,17 @@ func TestFindPreviousRevision(t *testing.T) {
 		// scenario 4
 		{
 			name: "ReadDir returns a directory that doesn't match prefix",
-			fakeIO: &fakeIO{
+			fakeIO: &monitortesting.FakeIO{
+				ExpectedStatFnCounter: 1,
+				StatFn: func(path string) (os.FileInfo, error) {
+					return monitortesting.FakeDir("static-pod-resources"), nil
+				},
 				ExpectedReadDirFnCounter: 1,
 				ReadDirFn: func(path string) ([]os.FileInfo, error) {
 					if path != "/etc/kubernetes/static-pod-resources" {
 						return nil, fmt.Errorf("unexpected path %s", path)
 					}
-					return []os.FileInfo{fakeDir("kube-abc-apiserver-pod-11")}, nil
+					return []os.FileInfo{monitortesting.FakeDir("kube-abc-apiserver-pod-11")}, nil
 				},
 			},
 		},
@@ -211,13 +223,17 @@ func TestFindPreviousRevision(t *testing.T) {
 		// scenario 5
 		{
 			name: "ReadDir returns a directory that has incorrect revision",
-			fakeIO: &fakeIO{
+			fakeIO: &monitortesting.FakeIO{
+				ExpectedStatFnCounter: 1,
+				StatFn: func(path string) (os.FileInfo, error) {
+					return monitortesting.FakeDir("static-pod-resources"), nil
+				},
 				ExpectedReadDirFnCounter: 1,
 				ReadDirFn: func(path string) ([]os.FileInfo, error) {
 					if path != "/etc/kubernetes/static-pod-resources" {
 						return nil, fmt.Errorf("unexpected path %s", path)
 					}
-					return []os.FileInfo{fakeDir("kube-apiserver-pod-FF")}, nil
+					return []os.FileInfo{monitortesting.FakeDir("kube-apiserver-pod-FF")}, nil
 				},
 			},
 			expectedErr: `strconv.Atoi: parsing "FF": invalid syntax`,
@@ -226,13 +242,17 @@ func TestFindPreviousRevision(t *testing.T) {
 		// scenario 6
 		{
 			name: "ReadDir returns a single directory",
-			fakeIO: &fakeIO{
+			fakeIO: &monitortesting.FakeIO{
+				ExpectedStatFnCounter: 1,
+				StatFn: func(path string) (os.FileInfo, error) {
+					return monitortesting.FakeDir("static-pod-resources"), nil
+				},
 				ExpectedReadDirFnCounter: 1,
 				ReadDirFn: func(path string) ([]os.FileInfo, error) {
 					if path != "/etc/kubernetes/static-pod-resources" {
 						return nil, fmt.Errorf("unexpected path %s", path)
 					}
-					return []os.FileInfo{fakeDir("kube-apiserver-pod-11")}, nil
+					return []os.FileInfo{monitortesting.FakeDir("kube-apiserver-pod-11")}, nil
 				},
 			},
 		},
@@ -240,13 +260,17 @@ func TestFindPreviousRevision(t *testing.T) {
 		// scenario 7
 		{
 			name: "prev rev found",
-			fakeIO: &fakeIO{
+			fakeIO: &monitortesting.FakeIO{
+				ExpectedStatFnCounter: 1,
+				StatFn: func(path string) (os.FileInfo, error) {
+					return monitortesting.FakeDir("static-pod-resources"), nil
+				},
 				ExpectedReadDirFnCounter: 1,
 				ReadDirFn: func(path string) ([]os.FileInfo, error) {
 					if path != "/etc/kubernetes/static-pod-resources" {
 						return nil, fmt.Errorf("unexpected path %s", path)
 					}
-					return []os.FileInfo{fakeDir("kube-apiserver-pod-11"), fakeDir("kube-apiserver-pod-12")}, nil
+					return []os.FileInfo{monitortesting.FakeDir("kube-apiserver-pod-11"), monitortesting.FakeDir("kube-apiserver-pod-12")}, nil
 				},
 			},
 			expectedPrevRev: 11,
@@ -256,13 +280,17 @@ func TestFindPreviousRevision(t *testing.T) {
 		// scenario 8
 		{
 			name: "prev rev found with sort",
-			fakeIO: &fakeIO{
+			fakeIO: &monitortesting.FakeIO{
+				ExpectedStatFnCounter: 1,
+				StatFn: func(path string) (os.FileInfo, error) {
+					return monitortesting.FakeDir("static-pod-resources"), nil
+				},
 				ExpectedReadDirFnCounter: 1,
 				ReadDirFn: func(path string) ([]os.FileInfo, error) {
 					if path != "/etc/kubernetes/static-pod-resources" {
 						return nil, fmt.Errorf("unexpected path %s", path)
 					}
-					return []os.FileInfo{fakeDir("kube-apiserver-pod-12"), fakeDir("kube-apiserver-pod-9")}, nil
+					return []os.FileInfo{monitortesting.FakeDir("kube-apiserver-pod-12"), monitortesting.FakeDir("kube-apiserver-pod-9")}, nil
 				},
 			},
 			expectedPrevRev: 9,
@@ -272,13 +300,17 @@ func TestFindPreviousRevision(t *testing.T) {
 		// scenario 9
 		{
 			name: "prev rev found with files that match the prefix",
-			fakeIO: &fakeIO{
+			fakeIO: &monitortesting.FakeIO{
+				ExpectedStatFnCounter: 1,
+				StatFn: func(path string) (os.FileInfo, error) {
+					return monitortesting.FakeDir("static-pod-resources"), nil
+				},
 				ExpectedReadDirFnCounter: 1,
 				ReadDirFn: func(path string) ([]os.FileInfo, error) {
 					if path != "/etc/kubernetes/static-pod-resources" {
 						return nil, fmt.Errorf("unexpected path %s", path)
 					}
-					return []os.FileInfo{fakeDir("kube-apiserver-pod-12"), fakeDir("kube-apiserver-pod-11"), fakeFile("kube-apiserver-pod-13"), fakeFile("kube-apiserver-pod-14")}, nil
+					return []os.FileInfo{monitortesting.FakeDir("kube-apiserver-pod-12"), monitortesting.FakeDir("kube-apiserver-pod-11"), monitortesting.FakeFile("kube-apiserver-pod-13"), monitortesting.FakeFile("kube-apiserver-pod-14")}, nil
 				},
 			},
 			expectedPrevRev: 11,
@@ -288,13 +320,17 @@ func TestFindPreviousRevision(t *testing.T) {
 		// scenario 10
 		{
 			name: "ReadDir returns an incorrect directory",
-			fakeIO: &fakeIO{
+			fakeIO: &monitortesting.FakeIO{
+				ExpectedStatFnCounter: 1,
+				StatFn: func(path string) (os.FileInfo, error) {
+					return monitortesting.FakeDir("static-pod-resources"), nil
+				},
 				ExpectedReadDirFnCounter: 1,
 				ReadDirFn: func(path string) ([]os.FileInfo, error) {
 					if path != "/etc/kubernetes/static-pod-resources" {
 						return nil, fmt.Errorf("unexpected path %s", path)
 					}
-					return []os.FileInfo{fakeDir("kube-apiserver-abc-11")}, nil
+					return []os.FileInfo{monitortesting.FakeDir("kube-apiserver-abc-11")}, nil
 				},
 			},
 			expectedErr: "unable to extract revision from kube-apiserver-abc-11 due to incorrect format",
@@ -319,7 +355,7 @@ func TestFindPreviousRevision(t *testing.T) {
 			if found != scenario.expectedFound {
 				t.Errorf("unexpected found %v, expected %v", found, scenario.expectedFound)
 			}
-			validateError(t, err, scenario.expectedErr)
+			monitortesting.AssertError(t, err, scenario.expectedErr)
 		})
 	}
 }
@@ -327,19 +363,19 @@ func TestFindPreviousRevision(t *testing.T) {
 func TestCreateLastKnowGoodRevisionAndExit(t *testing.T) {
 	scenarios := []struct {
 		name      string
-		fakeIO    *fakeIO
+		fakeIO    *monitortesting.FakeIO
 		expectErr string
 	}{
 		// scenario 1
 		{
 			name: "step 0: is a dir",
-			fakeIO: &fakeIO{
+			fakeIO: &monitortesting.FakeIO{
 				ExpectedStatFnCounter: 1,
 				StatFn: func(path string) (os.FileInfo, error) {
 					if path != "/etc/kubernetes/static-pod-resources/kube-apiserver-last-known-good" {
 						return nil, fmt.Errorf("unexpected path %s", path)
 					}
-					return fakeDir("fake-directory"), nil
+					return monitortesting.FakeDir("fake-directory"), nil
 				},
 			},
 			expectErr: "the provided path /etc/kubernetes/static-pod-resources/kube-apiserver-last-known-good is incorrect and points to a directory",
@@ -348,15 +384,16 @@ func TestCreateLastKnowGoodRevisionAndExit(t *testing.T) {
 		// scenario 2
 		{
 			name: "step 0: rm fails",
-			fakeIO: &fakeIO{
-				ExpectedStatFnCounter:   1,
-				ExpectedRemoveFnCounter: 1,
+			fakeIO: &monitortesting.FakeIO{
+				ExpectedStatFnCounter:     1,
+				ExpectedRemoveFnCounter:   1,
+				ExpectedReadFileFnCounter: 1,
 
 				StatFn: func(path string) (os.FileInfo, error) {
 					if path != "/etc/kubernetes/static-pod-resources/kube-apiserver-last-known-good" {
 						return nil, fmt.Errorf("unexpected path %s", path)
 					}
-					return fakeFile("fake-file"), nil
+					return monitortesting.FakeFile("fake-file"), nil
 				},
 				RemoveFn: func(path string) error {
 					if path != "/etc/kubernetes/static-pod-resources/kube-apiserver-last-known-good" {
@@ -371,13 +408,13 @@ func TestCreateLastKnowGoodRevisionAndExit(t *testing.T) {
 		// scenario 3
 		{
 			name: "step 0: !IsNotExists",
-			fakeIO: &fakeIO{
+			fakeIO: &monitortesting.FakeIO{
 				ExpectedStatFnCounter: 1,
 				StatFn: func(path string) (os.FileInfo, error) {
 					if path != "/etc/kubernetes/static-pod-resources/kube-apiserver-last-known-good" {
 						return nil, fmt.Errorf("unexpected path %s", path)
 					}
-					return fakeFile("fake-file"), fmt.Errorf("fake error")
+					return monitortesting.FakeFile("fake-file"), fmt.Errorf("fake error")
 				},
 			},
 			expectErr: "fake error",
@@ -386,14 +423,14 @@ func TestCreateLastKnowGoodRevisionAndExit(t *testing.T) {
 		// scenario 4
 		{
 			name: "step 1: SymLink err",
-			fakeIO: &fakeIO{
+			fakeIO: &monitortesting.FakeIO{
 				ExpectedStatFnCounter:    1,
 				ExpectedSymlinkFnCounter: 1,
 				StatFn: func(path string) (os.FileInfo, error) {
 					if path != "/etc/kubernetes/static-pod-resources/kube-apiserver-last-known-good" {
 						return nil, fmt.Errorf("unexpected path %s", path)
 					}
-					return fakeFile("fake-file"), os.ErrNotExist
+					return monitortesting.FakeFile("fake-file"), os.ErrNotExist
 				},
 				SymlinkFn: func(oldname, newname string) error {
 					if oldname != "/etc/kubernetes/static-pod-resources/kube-apiserver-pod-8/kube-apiserver-pod.yaml" {
@@ -411,15 +448,16 @@ func TestCreateLastKnowGoodRevisionAndExit(t *testing.T) {
 		// scenario 5
 		{
 			name: "step 2: suicide err",
-			fakeIO: &fakeIO{
-				ExpectedStatFnCounter:    1,
-				ExpectedSymlinkFnCounter: 1,
-				ExpectedRemoveFnCounter:  1,
+			fakeIO: &monitortesting.FakeIO{
+				ExpectedStatFnCounter:      1,
+				ExpectedSymlinkFnCounter:   1,
+				ExpectedRemoveFnCounter:    1,
+				ExpectedWriteFileFnCounter: 1,
 				StatFn: func(path string) (os.FileInfo, error) {
 					if path != "/etc/kubernetes/static-pod-resources/kube-apiserver-last-known-good" {
 						return nil, fmt.Errorf("unexpected path %s", path)
 					}
-					return fakeFile("fake-file"), os.ErrNotExist
+					return monitortesting.FakeFile("fake-file"), os.ErrNotExist
 				},
 				SymlinkFn: func(oldname, newname string) error {
 					if oldname != "/etc/kubernetes/static-pod-resources/kube-apiserver-pod-8/kube-apiserver-pod.yaml" {
@@ -430,6 +468,12 @@ func TestCreateLastKnowGoodRevisionAndExit(t *testing.T) {
 					}
 					return nil
 				},
+				WriteFileFn: func(filename string, data []byte, perm fs.FileMode) error {
+					if filename != "/etc/kubernetes/static-pod-resources/kube-apiserver-pod-8.protected" {
+						return fmt.Errorf("unexpected filename %s", filename)
+					}
+					return nil
+				},
 				RemoveFn: func(path string) error {
 					if path != "/etc/kubernetes/manifests/kube-apiserver-startup-monitor.yaml" {
 						return fmt.Errorf("unexpected path %s", path)
@@ -443,15 +487,16 @@ func TestCreateLastKnowGoodRevisionAndExit(t *testing.T) {
 		// scenario 6
 		{
 			name: "happy path",
-			fakeIO: &fakeIO{
-				ExpectedStatFnCounter:    1,
-				ExpectedSymlinkFnCounter: 1,
-				ExpectedRemoveFnCounter:  1,
+			fakeIO: &monitortesting.FakeIO{
+				ExpectedStatFnCounter:      1,
+				ExpectedSymlinkFnCounter:   1,
+				ExpectedRemoveFnCounter:    1,
+				ExpectedWriteFileFnCounter: 1,
 				StatFn: func(path string) (os.FileInfo, error) {
 					if path != "/etc/kubernetes/static-pod-resources/kube-apiserver-last-known-good" {
 						return nil, fmt.Errorf("unexpected path %s", path)
 					}
-					return fakeFile("fake-file"), os.ErrNotExist
+					return monitortesting.FakeFile("fake-file"), os.ErrNotExist
 				},
 				SymlinkFn: func(oldname, newname string) error {
 					if oldname != "/etc/kubernetes/static-pod-resources/kube-apiserver-pod-8/kube-apiserver-pod.yaml" {
@@ -462,6 +507,12 @@ func TestCreateLastKnowGoodRevisionAndExit(t *testing.T) {
 					}
 					return nil
 				},
+				WriteFileFn: func(filename string, data []byte, perm fs.FileMode) error {
+					if filename != "/etc/kubernetes/static-pod-resources/kube-apiserver-pod-8.protected" {
+						return fmt.Errorf("unexpected filename %s", filename)
+					}
+					return nil
+				},
 				RemoveFn: func(path string) error {
 					if path != "/etc/kubernetes/manifests/kube-apiserver-startup-monitor.yaml" {
 						return fmt.Errorf("unexpected path %s", path)
@@ -481,7 +532,7 @@ func TestCreateLastKnowGoodRevisionAndExit(t *testing.T) {
 			err := target.createLastKnowGoodRevisionAndDestroy()
 
 			// validate
-			validateError(t, err, scenario.expectErr)
+			monitortesting.AssertError(t, err, scenario.expectErr)
 			if err := scenario.fakeIO.Validate(); err != nil {
 				t.Error(err)
 			}
@@ -554,8 +605,8 @@ func TestLoadTargetManifestAndExtractRevision(t *testing.T) {
 	}
 }
 
-func createTestTarget(fakeIO *fakeIO) *StartupMonitor {
-	target := New(nil)
+func createTestTarget(fakeIO *monitortesting.FakeIO) *StartupMonitor {
+	target := New(nil).WithClockForTesting(clock.NewFakeClock(time.Unix(0, 0)))
 	target.io = fakeIO
 	target.revision = 8
 	target.targetName = "kube-apiserver"
@@ -563,137 +614,3 @@ func createTestTarget(fakeIO *fakeIO) *StartupMonitor {
 	target.manifestsPath = "/etc/kubernetes/manifests"
 	return target
 }
-
-type fakeIO struct {
-	StatFn                func(string) (os.FileInfo, error)
-	StatFnCounter         int
-	ExpectedStatFnCounter int
-
-	SymlinkFn                func(string, string) error
-	SymlinkFnCounter         int
-	ExpectedSymlinkFnCounter int
-
-	RemoveFn                func(string) error
-	RemoveFnCounter         int
-	ExpectedRemoveFnCounter int
-
-	ReadFileFn func(string) ([]byte, error)
-	ReadFileFnCounter int
-	ExpectedReadFileFnCounter int
-
-	ReadDirFn  func(string) ([]fs.FileInfo, error)
-	ReadDirFnCounter int
-	ExpectedReadDirFnCounter int
-
-	WriteFileFn func(filename string, data []byte, perm fs.FileMode) error
-	WriteFileFnCounter int
-	ExpectedWriteFileFnCounter int
-}
-
-func (f *fakeIO) Symlink(oldname string, newname string) error {
-	f.SymlinkFnCounter++
-	if f.SymlinkFn != nil {
-		return f.SymlinkFn(oldname, newname)
-	}
-	return nil
-}
-
-func (f *fakeIO) Stat(path string) (os.FileInfo, error) {
-	f.StatFnCounter++
-	if f.StatFn != nil {
-		return f.StatFn(path)
-	}
-	return nil, nil
-}
-
-func (f *fakeIO) Remove(path string) error {
-	f.RemoveFnCounter++
-	if f.RemoveFn != nil {
-		return f.RemoveFn(path)
-	}
-	return nil
-}
-
-func (f *fakeIO) ReadFile(filename string) ([]byte, error) {
-	f.ReadFileFnCounter++
-	if f.ReadFileFn != nil {
-		return f.ReadFileFn(filename)
-	}
-
-	return nil, nil
-}
-
-func (f *fakeIO) ReadDir(dirname string) ([]fs.FileInfo, error) {
-	f.ReadDirFnCounter++
-	if f.ReadDirFn != nil {
-		return f.ReadDirFn(dirname)
-	}
-	return nil, nil
-}
-
-func (f *fakeIO) WriteFile(filename string, data []byte, perm fs.FileMode) error {
-	f.WriteFileFnCounter++
-	if f.WriteFileFn != nil {
-		return f.WriteFileFn(filename, data, perm)
-	}
-	return nil
-}
-
-func (f *fakeIO) Validate() error {
-	var errs []error
-	if f.SymlinkFnCounter != f.ExpectedSymlinkFnCounter {
-		errs = append(errs, fmt.Errorf("unexpected SymlinkFnCounter %d, expected %d", f.SymlinkFnCounter, f.ExpectedSymlinkFnCounter))
-	}
-
-	if f.StatFnCounter != f.ExpectedStatFnCounter {
-		errs = append(errs, fmt.Errorf("unexpected StatFnCounter %d, expected %d", f.StatFnCounter, f.ExpectedStatFnCounter))
-	}
-
-	if f.RemoveFnCounter != f.ExpectedRemoveFnCounter {
-		errs = append(errs, fmt.Errorf("unexpected RemoveFnCounter %d, expected %d", f.RemoveFnCounter, f.ExpectedRemoveFnCounter))
-	}
-
-	if f.ReadFileFnCounter != f.ExpectedReadFileFnCounter {
-		errs = append(errs, fmt.Errorf("unexpected ReadFileFnCounter %d, expected %d", f.ReadFileFnCounter, f.ExpectedReadFileFnCounter))
-	}
-
-	if f.ReadDirFnCounter != f.ExpectedReadDirFnCounter {
-		errs = append(errs, fmt.Errorf("unexpected ReadDirFnCounter %d, expected %d", f.ReadDirFnCounter, f.ExpectedReadDirFnCounter))
-	}
-
-	if f.WriteFileFnCounter != f.ExpectedWriteFileFnCounter {
-		errs = append(errs, fmt.Errorf("unexpected WriteFileFnCounter %d, expected %d", f.WriteFileFnCounter, f.ExpectedWriteFileFnCounter))
-	}
-
-	return kerrors.NewAggregate(errs)
-}
-
-type fakeFile string
-
-func (f fakeFile) Name() string       { return string(f) }
-func (f fakeFile) Size() int64        { return 0 }
-func (f fakeFile) Mode() fs.FileMode  { return fs.ModeAppend }
-func (f fakeFile) ModTime() time.Time { return time.Unix(0, 0) }
-func (f fakeFile) IsDir() bool        { return false }
-func (f fakeFile) Sys() interface{}   { return nil }
-
-type fakeDir string
-
-func (f fakeDir) Name() string       { return string(f) }
-func (f fakeDir) Size() int64        { return 0 }
-func (f fakeDir) Mode() fs.FileMode  { return fs.ModeDir | 0500 }
-func (f fakeDir) ModTime() time.Time { return time.Unix(0, 0) }
-func (f fakeDir) IsDir() bool        { return true }
-func (f fakeDir) Sys() interface{}   { return nil }
-
-func validateError(t *testing.T, actualErr error, expectedErr string) {
-	if actualErr != nil && len(expectedErr) == 0 {
-		t.Fatalf("unexpected error %v", actualErr)
-	}
-	if actualErr == nil && len(expectedErr) > 0 {
-		t.Fatal("expected to get an error")
-	}
-	if actualErr != nil && actualErr.Error() != expectedErr {
-		t.Fatalf("incorrect error: %v, expected: %v", actualErr, expectedErr)
-	}
-}
@@ -0,0 +1,69 @@
+package monitor
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"time"
+)
+
+// StateFileRecord is the JSON schema written into the state file by writeStateFile, giving the
+// installer and pruner a machine-readable summary of the monitor's outcome for its target
+// without depending on a Kubernetes client or the monitor's own in-memory state.
+type StateFileRecord struct {
+	// TargetName is the operand this record describes.
+	TargetName string `json:"targetName"`
+	// Revision is the revision the monitor was guarding when this record was written.
+	Revision int `json:"revision"`
+	// State is the monitor's coarse-grained assessment of the target, mirroring the states
+	// reported via WithNodeStateReporting.
+	State NodeState `json:"state"`
+	// Message elaborates on State, e.g. a fallback reason. Empty unless State warrants one.
+	Message string `json:"message,omitempty"`
+	// LastTransitionTime is when this record was written, RFC3339 in UTC.
+	LastTransitionTime string `json:"lastTransitionTime"`
+	// ProbeCount is how many times the health checker has been called so far.
+	ProbeCount int `json:"probeCount"`
+	// LastProbeError is the error returned by the most recent health checker call, if any.
+	LastProbeError string `json:"lastProbeError,omitempty"`
+	// FallbackFromRevision and FallbackToRevision record the revisions involved in the most
+	// recent fallback. Both are 0 unless State is NodeStateFallbackPerformed.
+	FallbackFromRevision int `json:"fallbackFromRevision,omitempty"`
+	FallbackToRevision   int `json:"fallbackToRevision,omitempty"`
+}
+
+// stateFilePath returns the path of the JSON state file describing sm's current state for its
+// target, namespaced per target so several monitors sharing stateFileDir don't collide.
+func (sm *StartupMonitor) stateFilePath() string {
+	return path.Join(sm.stateFileDir, fmt.Sprintf("%s.json", sm.targetName))
+}
+
+// writeStateFile writes a StateFileRecord describing state to stateFilePath, so the installer
+// and pruner can decide whether a revision rollout failed without a Kubernetes client. It is
+// best-effort, and a no-op unless WithStateFileDir was set.
+func (sm *StartupMonitor) writeStateFile(state NodeState, message string, fallbackFromRevision, fallbackToRevision int) error {
+	if sm.stateFileDir == "" {
+		return nil
+	}
+
+	sm.lock.Lock()
+	probeCount, lastProbeErr := sm.probeCount, sm.lastProbeErr
+	sm.lock.Unlock()
+
+	encoded, err := json.MarshalIndent(StateFileRecord{
+		TargetName:           sm.targetName,
+		Revision:             sm.revision,
+		State:                state,
+		Message:              message,
+		LastTransitionTime:   sm.clock.Now().UTC().Format(time.RFC3339),
+		ProbeCount:           probeCount,
+		LastProbeError:       lastProbeErr,
+		FallbackFromRevision: fallbackFromRevision,
+		FallbackToRevision:   fallbackToRevision,
+	}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode the state file for %s: %v", sm.targetName, err)
+	}
+
+	return retryOnTransientError(func() error { return sm.io.WriteFileAtomic(sm.stateFilePath(), encoded, 0644) })
+}
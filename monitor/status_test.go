@@ -0,0 +1,40 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestGetStatus(t *testing.T) {
+	target := New(HealthCheckerFunc(func(ctx context.Context) (bool, string, error) { return true, "", nil }))
+	target.revision = 8
+	target.timeout = time.Minute
+
+	if status := target.GetStatus(); status.Phase != "" || !status.Deadline.IsZero() {
+		t.Fatalf("expected an empty status before the first sync, got %+v", status)
+	}
+
+	monitorTimeStamp := target.recordMonitorStart()
+	target.recordProbeResult(true)
+	target.recordSyncError(fmt.Errorf("fake error"))
+	target.setPhase(PhaseHealthy)
+
+	status := target.GetStatus()
+	if status.Phase != PhaseHealthy {
+		t.Errorf("unexpected phase %v, expected %v", status.Phase, PhaseHealthy)
+	}
+	if status.Revision != 8 {
+		t.Errorf("unexpected revision %d, expected 8", status.Revision)
+	}
+	if !status.LastProbeHealthy {
+		t.Error("expected LastProbeHealthy to be true")
+	}
+	if status.LastError == nil || status.LastError.Error() != "fake error" {
+		t.Errorf("unexpected LastError %v", status.LastError)
+	}
+	if !status.Deadline.Equal(monitorTimeStamp.Add(target.timeout)) {
+		t.Errorf("unexpected deadline %v", status.Deadline)
+	}
+}
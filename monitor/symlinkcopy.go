@@ -0,0 +1,35 @@
+package monitor
+
+import (
+	"errors"
+	"syscall"
+
+	"k8s.io/klog/v2"
+)
+
+// symlinkOrCopy creates a symlink at newname pointing to oldname. If the symlink fails with
+// EXDEV (oldname and newname live on different mounts) or EPERM (symlink creation is blocked
+// by a hardened security policy), it falls back to copying oldname's contents to newname
+// instead of aborting, since a copy achieves the same last-known-good guarantee at the cost of
+// not tracking future writes to oldname.
+func (sm *StartupMonitor) symlinkOrCopy(oldname, newname string) error {
+	err := sm.io.Symlink(oldname, newname)
+	if err == nil {
+		klog.Infof("Created a symlink %s for %s", newname, oldname)
+		return nil
+	}
+	if !errors.Is(err, syscall.EXDEV) && !errors.Is(err, syscall.EPERM) {
+		return err
+	}
+
+	klog.Infof("Failed to symlink %s for %s (%v), falling back to copying the manifest", newname, oldname, err)
+	data, err := sm.io.ReadFile(oldname)
+	if err != nil {
+		return err
+	}
+	if err := retryOnTransientError(func() error { return sm.io.WriteFileAtomic(newname, data, 0644) }); err != nil {
+		return err
+	}
+	klog.Infof("Copied %s to %s", oldname, newname)
+	return nil
+}
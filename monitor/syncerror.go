@@ -0,0 +1,59 @@
+package monitor
+
+import "errors"
+
+// SyncErrorCategory classifies the operation that produced an error returned from a sync
+// iteration, so syncErrorsTotal and GetStatus can distinguish a monitor erroring continuously
+// on one specific operation from one that is otherwise healthy and just hit a transient error.
+type SyncErrorCategory string
+
+const (
+	// SyncErrorCategoryManifestRead covers errors reading or parsing the root manifest.
+	SyncErrorCategoryManifestRead SyncErrorCategory = "ManifestRead"
+
+	// SyncErrorCategoryLastKnownGood covers errors maintaining the last-known-good revision
+	// symlink once the target is observed healthy.
+	SyncErrorCategoryLastKnownGood SyncErrorCategory = "LastKnownGood"
+
+	// SyncErrorCategoryFallback covers errors performing the fallback procedure itself.
+	SyncErrorCategoryFallback SyncErrorCategory = "Fallback"
+
+	// SyncErrorCategoryInstallerLock covers a failure to acquire the installer coordination
+	// lock (see WithInstallerLockFilePath) within its timeout.
+	SyncErrorCategoryInstallerLock SyncErrorCategory = "InstallerLock"
+
+	// SyncErrorCategoryUnknown covers errors returned from sync that weren't tagged with a
+	// more specific category.
+	SyncErrorCategoryUnknown SyncErrorCategory = "Unknown"
+)
+
+// syncError tags an error returned from a sync iteration with the category of operation that
+// produced it.
+type syncError struct {
+	category SyncErrorCategory
+	err      error
+}
+
+func (e *syncError) Error() string { return e.err.Error() }
+func (e *syncError) Unwrap() error { return e.err }
+
+// wrapSyncError tags err, if non-nil, with category for classification by syncErrorWrapper.
+func wrapSyncError(category SyncErrorCategory, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &syncError{category: category, err: err}
+}
+
+// syncErrorCategoryOf returns the SyncErrorCategory tagged onto err by wrapSyncError, or
+// SyncErrorCategoryUnknown if err is nil or wasn't tagged.
+func syncErrorCategoryOf(err error) SyncErrorCategory {
+	if err == nil {
+		return ""
+	}
+	var classified *syncError
+	if errors.As(err, &classified) {
+		return classified.category
+	}
+	return SyncErrorCategoryUnknown
+}
@@ -0,0 +1,25 @@
+package monitor
+
+import (
+	"os"
+
+	"k8s.io/klog/v2"
+)
+
+// defaultTerminationMessagePath is the path the kubelet watches for every container's
+// termination message by convention, letting `kubectl describe pod` surface it without needing
+// log access.
+const defaultTerminationMessagePath = "/dev/termination-log"
+
+// writeTerminationMessage writes msg to path, so a kubelet watching path for this container
+// surfaces a concise reason for the exit via `kubectl describe pod` without needing log access.
+// It is best-effort: a failure to write is logged but never escalated, since the process is
+// already on its way out. A blank path disables it.
+func writeTerminationMessage(path, msg string) {
+	if path == "" {
+		return
+	}
+	if err := os.WriteFile(path, []byte(msg), 0644); err != nil {
+		klog.Warningf("Unable to write the termination message to %s: %v", path, err)
+	}
+}
@@ -0,0 +1,27 @@
+package monitor
+
+import (
+	"os"
+	"path"
+	"testing"
+)
+
+func TestWriteTerminationMessage(t *testing.T) {
+	dir := t.TempDir()
+	p := path.Join(dir, "termination-log")
+
+	writeTerminationMessage(p, "boom")
+
+	got, err := os.ReadFile(p)
+	if err != nil {
+		t.Fatalf("failed to read the termination message: %v", err)
+	}
+	if string(got) != "boom" {
+		t.Errorf("unexpected termination message %q, expected %q", got, "boom")
+	}
+}
+
+func TestWriteTerminationMessageDisabledWhenPathEmpty(t *testing.T) {
+	// must not panic or attempt to write to an empty path
+	writeTerminationMessage("", "boom")
+}
@@ -0,0 +1,35 @@
+package monitor
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// infrastructureResource is the singleton config.openshift.io/v1 Infrastructure resource
+// that reports the cluster's control plane topology.
+var infrastructureResource = schema.GroupVersionResource{Group: "config.openshift.io", Version: "v1", Resource: "infrastructures"}
+
+const infrastructureResourceName = "cluster"
+
+// isSingleReplicaTopology reports whether the cluster's Infrastructure resource declares a
+// SingleReplica control plane topology (SNO). It requires sm.dynamicClient; when it is unset
+// it reports false so the monitor keeps its configured timeout.
+func (sm *StartupMonitor) isSingleReplicaTopology(ctx context.Context) (bool, error) {
+	if !sm.hasDynamicClient() {
+		return false, nil
+	}
+
+	infrastructure, err := sm.dynamicClient.Resource(infrastructureResource).Get(ctx, infrastructureResourceName, metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	topology, found, err := unstructured.NestedString(infrastructure.Object, "status", "controlPlaneTopology")
+	if err != nil {
+		return false, err
+	}
+	return found && topology == "SingleReplica", nil
+}
@@ -0,0 +1,36 @@
+package monitor
+
+import (
+	"fmt"
+	"path"
+	"time"
+)
+
+// verdictFileName returns the name of the verdict marker file written into a revision's
+// resource directory, alongside its manifest.
+func (sm *StartupMonitor) verdictFileName() string {
+	return fmt.Sprintf("%s-verdict", sm.targetName)
+}
+
+// verdictPathFor returns the path of the verdict marker file for the given revision, so the
+// installer and pruner can consult a revision's outcome without depending on the monitor's
+// own in-memory state or its last-known-good pointers.
+func (sm *StartupMonitor) verdictPathFor(revision int) string {
+	return path.Join(path.Dir(sm.targetManifestPathFor(revision)), sm.verdictFileName())
+}
+
+// writeHealthyVerdict records, inside revision's resource directory, that the target was
+// observed healthy on this node. It is best-effort: a failure to write the marker must not
+// block the healthy transition it documents.
+func (sm *StartupMonitor) writeHealthyVerdict(revision int) error {
+	content := fmt.Sprintf("healthy-at: %s\n", sm.clock.Now().UTC().Format(time.RFC3339))
+	return retryOnTransientError(func() error { return sm.io.WriteFile(sm.verdictPathFor(revision), []byte(content), 0644) })
+}
+
+// writeFailedVerdict records, inside revision's resource directory, that this node gave up on
+// the target and fell back away from it, along with why. It is best-effort: a failure to write
+// the marker must not block the fallback it documents.
+func (sm *StartupMonitor) writeFailedVerdict(revision int, reason string) error {
+	content := fmt.Sprintf("failed-at: %s\nreason: %s\n", sm.clock.Now().UTC().Format(time.RFC3339), reason)
+	return retryOnTransientError(func() error { return sm.io.WriteFile(sm.verdictPathFor(revision), []byte(content), 0644) })
+}
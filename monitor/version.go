@@ -0,0 +1,7 @@
+package monitor
+
+// Version is the startup-monitor binary's version, applied as the monitor-version fallback
+// annotation (see monitorVersionAnnotationSuffix) so an operator can tell which build performed
+// a given fallback. It is meant to be overridden at build time via
+// -ldflags "-X <module>/monitor.Version=...". Left unset, the default, it reports "unknown".
+var Version = "unknown"
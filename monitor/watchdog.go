@@ -0,0 +1,55 @@
+package monitor
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+)
+
+// watchdogMissedIntervals is how many probe intervals may pass without a completed sync
+// iteration before runWatchdogLoop considers the sync loop wedged. A single slow tick can be
+// transient; this many in a row means the loop is stuck, most likely on I/O that ignored its
+// context deadline.
+const watchdogMissedIntervals = 5
+
+// watchdogExit terminates the process once runWatchdogLoop decides the sync loop is wedged.
+// Overridable in tests so they can observe the watchdog firing without actually terminating the
+// test binary.
+var watchdogExit = os.Exit
+
+// runWatchdogLoop terminates the process if no sync iteration completes for
+// watchdogMissedIntervals*sm.probeInterval, so a sync loop wedged on I/O doesn't leave a
+// control-plane node unguarded forever; the kubelet restarts the monitor's static pod once the
+// process exits. lastSyncCompletion is seeded before this loop is started (see Run), so a sync
+// that wedges on its very first call is caught too, not just one that wedges after previously
+// completing at least once.
+func (sm *StartupMonitor) runWatchdogLoop(stopCh <-chan struct{}) {
+	threshold := time.Duration(watchdogMissedIntervals) * sm.probeInterval
+	wait.JitterUntil(func() {
+		age, completed := sm.syncCompletionAge()
+		if !completed || age <= threshold {
+			return
+		}
+		klog.Errorf("Watchdog: no sync iteration for %s completed in over %s, dumping goroutine stacks and terminating so the kubelet restarts the monitor", sm.targetName, threshold)
+		dumpGoroutineStacks()
+		writeTerminationMessage(sm.terminationMessagePath, fmt.Sprintf("Watchdog: no sync iteration for %s completed in over %s", sm.targetName, threshold))
+		watchdogExit(1)
+	}, sm.probeInterval, loopJitterFactor, true, stopCh)
+}
+
+// dumpGoroutineStacks logs the stack of every goroutine, not just the caller's, so the wedged
+// sync loop's stack is captured even though the watchdog runs on its own goroutine.
+func dumpGoroutineStacks() {
+	buf := make([]byte, 1<<20)
+	for {
+		if n := runtime.Stack(buf, true); n < len(buf) {
+			klog.Errorf("%s", buf[:n])
+			return
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+}
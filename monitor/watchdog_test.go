@@ -0,0 +1,77 @@
+package monitor
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestRunWatchdogLoopFiresOnFirstIterationHang exercises runWatchdogLoop against a sync loop
+// that never completes even a single iteration, e.g. wedged on I/O during startup: since
+// lastSyncCompletion is seeded before the watchdog loop starts (see Run), syncCompletionAge
+// reports completed=true from the very first tick, so the watchdog still notices the hang and
+// fires instead of treating "never completed" the same as "not yet due".
+func TestRunWatchdogLoopFiresOnFirstIterationHang(t *testing.T) {
+	target := New(nil)
+	target.probeInterval = time.Millisecond
+	target.lastSyncCompletion = time.Now() // what Run seeds before starting the loop
+
+	var fired int32
+	old := watchdogExit
+	watchdogExit = func(code int) { atomic.StoreInt32(&fired, 1) }
+	defer func() { watchdogExit = old }()
+
+	stopCh := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		target.runWatchdogLoop(stopCh)
+		close(done)
+	}()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for atomic.LoadInt32(&fired) == 0 {
+		if time.Now().After(deadline) {
+			close(stopCh)
+			<-done
+			t.Fatalf("watchdog never fired for a sync loop that never completed a single iteration")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	close(stopCh)
+	<-done
+}
+
+// TestRunWatchdogLoopDoesNotFireBeforeThreshold exercises runWatchdogLoop against a sync loop
+// that keeps completing iterations well within watchdogMissedIntervals*probeInterval, and
+// asserts it never fires.
+func TestRunWatchdogLoopDoesNotFireBeforeThreshold(t *testing.T) {
+	target := New(nil)
+	target.probeInterval = 50 * time.Millisecond
+	target.recordSyncCompletion()
+
+	var fired int32
+	old := watchdogExit
+	watchdogExit = func(code int) { atomic.StoreInt32(&fired, 1) }
+	defer func() { watchdogExit = old }()
+
+	stopCh := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		target.runWatchdogLoop(stopCh)
+		close(done)
+	}()
+
+	deadline := time.Now().Add(150 * time.Millisecond)
+	for time.Now().Before(deadline) {
+		target.recordSyncCompletion()
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	close(stopCh)
+	<-done
+
+	if atomic.LoadInt32(&fired) != 0 {
+		t.Errorf("watchdog fired even though sync iterations kept completing within the threshold")
+	}
+}